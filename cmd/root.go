@@ -19,6 +19,7 @@ import (
 	"os"
 	"path"
 
+	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command"
 	"github.com/mitchellh/go-homedir"
@@ -71,7 +72,15 @@ func GetRootCommand() *cobra.Command {
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		// errors go to stderr, so they do not corrupt json or yaml output written to stdout
+		fmt.Fprintln(os.Stderr, err)
+
+		// commands waiting for operations tell failures and timeouts apart with exit codes
+		var exitCoder interface{ ExitCode() int }
+		if errors.As(err, &exitCoder) {
+			os.Exit(exitCoder.ExitCode())
+		}
+
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,167 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
+)
+
+type fakeContext struct {
+	cli.Context
+}
+
+func (fakeContext) OrganizationID() int32 { return 1 }
+
+type fakeCli struct {
+	cli.Cli
+	client *pipeline.APIClient
+	out    bytes.Buffer
+}
+
+func (c *fakeCli) Client() *pipeline.APIClient { return c.client }
+func (c *fakeCli) Context() cli.Context        { return fakeContext{} }
+func (c *fakeCli) Out() io.Writer              { return &c.out }
+func (c *fakeCli) Color() bool                 { return false }
+func (c *fakeCli) OutputFormat() string        { return output.OutputFormatJSON }
+
+// fakePipeline serves the cluster and node pool API of an organization with a single cluster created on demand.
+// The created cluster is reported as CREATING for the given number of cluster list requests.
+type fakePipeline struct {
+	mu          sync.Mutex
+	cluster     *pipeline.GetClusterStatusResponse
+	creatingFor int
+	nodePools   []pipeline.CreateNodePoolRequest
+	unexpected  []string
+}
+
+func (p *fakePipeline) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	route := r.Method + " " + r.URL.Path
+	switch route {
+	case "GET /api/v1/orgs/1/secrets":
+		writeJSON(w, http.StatusOK, []pipeline.SecretItem{})
+	case "GET /api/v1/orgs/1/clusters":
+		clusters := []pipeline.GetClusterStatusResponse{}
+		if p.cluster != nil {
+			if p.creatingFor > 0 {
+				p.creatingFor--
+			} else {
+				p.cluster.Status = "RUNNING"
+			}
+			clusters = append(clusters, *p.cluster)
+		}
+		writeJSON(w, http.StatusOK, clusters)
+	case "POST /api/v1/orgs/1/clusters":
+		var request map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		name, _ := request["name"].(string)
+		p.cluster = &pipeline.GetClusterStatusResponse{Id: 7, Name: name, Status: "CREATING"}
+		writeJSON(w, http.StatusAccepted, pipeline.CreateClusterResponse202{Id: 7, Name: name})
+	case "GET /api/v1/orgs/1/clusters/7/nodepools":
+		writeJSON(w, http.StatusOK, []pipeline.NodePoolSummary{})
+	case "POST /api/v1/orgs/1/clusters/7/nodepools":
+		if p.cluster.Status != "RUNNING" {
+			http.Error(w, `{"message":"cluster is not running"}`, http.StatusConflict)
+			return
+		}
+		var request pipeline.CreateNodePoolRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		p.nodePools = append(p.nodePools, request)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		p.unexpected = append(p.unexpected, route)
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestApplyNewClusterWithNodePool(t *testing.T) {
+	fake := &fakePipeline{creatingFor: 2}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	config := pipeline.NewConfiguration()
+	config.BasePath = server.URL
+	banzaiCli := &fakeCli{client: pipeline.NewAPIClient(config)}
+
+	dir, err := ioutil.TempDir("", "apply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "cluster.yaml")
+	err = ioutil.WriteFile(file, []byte(`kind: NodePool
+cluster: demo
+spec:
+  name: pool1
+  size: 3
+  instanceType: t2.medium
+---
+kind: Cluster
+name: demo
+spec:
+  cloud: amazon
+  location: eu-west-1
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := applyOptions{files: []string{file}, wait: wait.NewOptions(&cobra.Command{})}
+	if err := runApply(banzaiCli, options); err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+
+	if len(fake.unexpected) > 0 {
+		t.Errorf("unexpected requests: %v", fake.unexpected)
+	}
+
+	if len(fake.nodePools) != 1 || fake.nodePools[0].Name != "pool1" {
+		t.Errorf("expected node pool pool1 to be created, got %v", fake.nodePools)
+	}
+
+	var results []Result
+	if err := json.Unmarshal(banzaiCli.out.Bytes(), &results); err != nil {
+		t.Fatalf("failed to parse results %q: %v", banzaiCli.out.String(), err)
+	}
+
+	if len(results) != 2 || results[0].Action != ActionCreate || results[1].Action != ActionCreate {
+		t.Errorf("expected the cluster and the node pool to be created, got %+v", results)
+	}
+}
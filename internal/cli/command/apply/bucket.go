@@ -0,0 +1,86 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+type bucketHandler struct {
+	*resolver
+}
+
+// bucketCloud returns the cloud provider of a bucket spec, which is the key of its properties.
+func bucketCloud(spec map[string]interface{}) string {
+	properties, _ := spec["properties"].(map[string]interface{})
+	for cloud := range properties {
+		return cloud
+	}
+
+	return ""
+}
+
+func (h bucketHandler) current(ctx context.Context, doc Document) (map[string]interface{}, error) {
+	buckets, _, err := h.client().StorageApi.ListObjectStoreBuckets(ctx, h.orgID, nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list buckets")
+	}
+
+	cloud := bucketCloud(doc.Spec)
+	for _, b := range buckets {
+		if b.Name != doc.Name || (cloud != "" && b.Cloud != cloud) {
+			continue
+		}
+
+		properties := map[string]interface{}{"location": b.Location}
+		if b.Cloud == "azure" {
+			properties["storageAccount"] = b.Aks.StorageAccount
+			properties["resourceGroup"] = b.Aks.ResourceGroup
+		}
+
+		return map[string]interface{}{
+			"name":       b.Name,
+			"properties": map[string]interface{}{b.Cloud: properties},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (h bucketHandler) desired(_ context.Context, doc Document) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"name":       doc.Spec["name"],
+		"properties": doc.Spec["properties"],
+	}, nil
+}
+
+func (h bucketHandler) create(ctx context.Context, doc Document) error {
+	var request pipeline.CreateObjectStoreBucketRequest
+	if err := fromMap(doc.Spec, &request); err != nil {
+		return errors.WrapIf(err, "invalid bucket spec")
+	}
+
+	_, _, err := h.client().StorageApi.CreateObjectStoreBucket(ctx, h.orgID, request)
+
+	return errors.WrapIf(err, "failed to create bucket")
+}
+
+func (h bucketHandler) update(_ context.Context, doc Document, changes []string) error {
+	return onlyChanged(doc, changes)
+}
@@ -0,0 +1,211 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+type clusterHandler struct {
+	*resolver
+}
+
+// nodePoolFields are the node pool fields of a cluster create request which are also reported by GetCluster.
+var nodePoolFields = []string{"instanceType", "spotPrice", "autoscaling", "count", "minCount", "maxCount", "image", "labels"}
+
+// clusterVersion returns the Kubernetes version of a cluster create request,
+// which is stored under the distribution specific properties, or directly in the request of PKE on Azure and vSphere clusters.
+func clusterVersion(spec map[string]interface{}) string {
+	if version := kubernetesVersion(spec); version != "" {
+		return version
+	}
+
+	properties, _ := spec["properties"].(map[string]interface{})
+	for _, p := range properties {
+		distribution, _ := p.(map[string]interface{})
+		if version := kubernetesVersion(distribution); version != "" {
+			return version
+		}
+	}
+
+	return ""
+}
+
+func kubernetesVersion(m map[string]interface{}) string {
+	for _, key := range []string{"version", "kubernetesVersion"} {
+		if version, ok := m[key].(string); ok {
+			return version
+		}
+	}
+
+	if master, ok := m["master"].(map[string]interface{}); ok {
+		if version, ok := master["version"].(string); ok {
+			return version
+		}
+	}
+
+	kubernetes, _ := m["kubernetes"].(map[string]interface{})
+	version, _ := kubernetes["version"].(string)
+
+	return version
+}
+
+// clusterNodePools returns the node pools of a cluster create request by their names.
+// Node pools are either listed directly in the request, or under the distribution specific properties, as a map or as a list.
+func clusterNodePools(spec map[string]interface{}) map[string]map[string]interface{} {
+	nodePools := make(map[string]map[string]interface{})
+
+	collect := func(m map[string]interface{}) {
+		for _, key := range []string{"nodePools", "nodepools"} {
+			switch pools := m[key].(type) {
+			case map[string]interface{}:
+				for name, pool := range pools {
+					if pool, ok := pool.(map[string]interface{}); ok {
+						nodePools[name] = pool
+					}
+				}
+			case []interface{}:
+				for _, pool := range pools {
+					pool, _ := pool.(map[string]interface{})
+					if name, ok := pool["name"].(string); ok {
+						nodePools[name] = pool
+					}
+				}
+			}
+		}
+	}
+
+	collect(spec)
+	properties, _ := spec["properties"].(map[string]interface{})
+	for _, p := range properties {
+		if distribution, ok := p.(map[string]interface{}); ok {
+			collect(distribution)
+		}
+	}
+
+	return nodePools
+}
+
+func (h clusterHandler) current(ctx context.Context, doc Document) (map[string]interface{}, error) {
+	summary, err := h.findCluster(ctx, doc.Name)
+	if err != nil || summary == nil {
+		return nil, err
+	}
+
+	cluster, _, err := h.client().ClustersApi.GetCluster(ctx, h.orgID, summary.Id)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to get cluster details")
+	}
+
+	current := map[string]interface{}{
+		"name":     cluster.Name,
+		"cloud":    cluster.Cloud,
+		"location": cluster.Location,
+		"version":  cluster.Version,
+	}
+
+	if len(cluster.NodePools) > 0 {
+		nodePools := make(map[string]interface{}, len(cluster.NodePools))
+		for name, np := range cluster.NodePools {
+			if nodePools[name], err = toMap(np); err != nil {
+				return nil, err
+			}
+		}
+
+		current["nodePools"] = nodePools
+	}
+
+	return current, nil
+}
+
+// desired returns the fields of the cluster that can be compared with the live state.
+// The size of autoscaled node pools is not compared, as it is changed by the autoscaler.
+func (h clusterHandler) desired(_ context.Context, doc Document) (map[string]interface{}, error) {
+	desired := map[string]interface{}{"name": doc.Name}
+	for _, key := range []string{"cloud", "location"} {
+		if value, ok := doc.Spec[key]; ok {
+			desired[key] = value
+		}
+	}
+
+	if version := clusterVersion(doc.Spec); version != "" {
+		desired["version"] = version
+	}
+
+	if specNodePools := clusterNodePools(doc.Spec); len(specNodePools) > 0 {
+		nodePools := make(map[string]interface{}, len(specNodePools))
+		for name, pool := range specNodePools {
+			nodePool := make(map[string]interface{})
+			for _, field := range nodePoolFields {
+				if value, ok := pool[field]; ok {
+					nodePool[field] = value
+				}
+			}
+
+			if autoscaling, _ := pool["autoscaling"].(bool); autoscaling {
+				delete(nodePool, "count")
+			}
+
+			nodePools[name] = nodePool
+		}
+
+		desired["nodePools"] = nodePools
+	}
+
+	return desired, nil
+}
+
+// create creates the cluster with secret names resolved to secret IDs, the same way as integrated service specs.
+func (h clusterHandler) create(ctx context.Context, doc Document) error {
+	ids, err := h.secretIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	spec, err := resolveSecretNames(doc.Spec, ids)
+	if err != nil {
+		return errors.WrapIff(err, "invalid spec of %s", doc)
+	}
+
+	_, _, err = h.client().ClustersApi.CreateCluster(ctx, h.orgID, spec)
+
+	return errors.WrapIf(err, "failed to create cluster")
+}
+
+// ignored reports the node pools of an existing cluster as not applied, they are updated with NodePool documents.
+func (h clusterHandler) ignored(path string) bool {
+	return path == "nodePools" || strings.HasPrefix(path, "nodePools.")
+}
+
+func (h clusterHandler) update(ctx context.Context, doc Document, changes []string) error {
+	if err := onlyChanged(doc, changes, "version"); err != nil {
+		return err
+	}
+
+	clusterID, err := h.runningClusterID(ctx, doc.Name)
+	if err != nil {
+		return err
+	}
+
+	request := pipeline.UpdateClusterRequest{Version: clusterVersion(doc.Spec)}
+	_, err = h.client().ClustersApi.UpdateCluster(ctx, h.orgID, clusterID, request)
+
+	return errors.WrapIf(err, "failed to update cluster")
+}
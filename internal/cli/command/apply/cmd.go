@@ -0,0 +1,227 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
+)
+
+// Actions taken on a document.
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionUnchanged = "unchanged"
+	ActionSkipped   = "skipped"
+)
+
+type applyOptions struct {
+	files []string
+	wait  *wait.Options
+}
+
+// Result describes the action taken on a document.
+type Result struct {
+	Kind    string   `json:"kind"`
+	Name    string   `json:"name"`
+	Cluster string   `json:"cluster,omitempty"`
+	Action  string   `json:"action"`
+	Changes []string `json:"changes,omitempty"`
+	Ignored []string `json:"ignored,omitempty"`
+}
+
+// NewApplyCommand returns a cobra command for applying resource descriptors.
+func NewApplyCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := applyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create or update Pipeline resources described in files",
+		Long: "Create or update Pipeline resources described in YAML or JSON documents.\n\n" +
+			"Every document has a kind (Secret, Bucket, Cluster, NodePool or IntegratedService), a name, " +
+			"a cluster for NodePool and IntegratedService documents, and a spec which is the same descriptor " +
+			"the corresponding create command accepts. Multiple documents can be separated with ---.\n\n" +
+			"Cluster and IntegratedService specs can refer to secrets by name with secretName instead of secretId.\n\n" +
+			"Documents are applied in dependency order: missing resources are created, existing ones are updated " +
+			"if their spec differs from the live state, and unchanged ones are skipped. " +
+			"Applying stops at the first failure.\n\n" +
+			"NodePool and IntegratedService documents of a cluster which is being created or updated, " +
+			"for example by a Cluster document of the same run, are applied once the cluster is running. " +
+			"With --wait, created clusters are waited for even if no other document depends on them.\n\n" +
+			"The node pools of existing clusters are compared with Cluster documents, but they are only updated by NodePool documents.",
+		Example: `  banzai apply -f cluster.yaml -f services/
+  cat resources.yaml | banzai apply -f -`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runApply(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringArrayVarP(&options.files, "file", "f", nil, "Document file or directory to apply (\"-\" for stdin), can be repeated")
+
+	options.wait = wait.NewOptions(cmd)
+
+	return cmd
+}
+
+func runApply(banzaiCli cli.Cli, options applyOptions) error {
+	if len(options.files) == 0 {
+		return errors.New("at least one --file must be specified")
+	}
+
+	documents, err := ReadDocuments(options.files)
+	if err != nil {
+		return err
+	}
+
+	resolver := newResolver(banzaiCli)
+	resolver.wait = options.wait
+	ctx := context.Background()
+
+	results := make([]Result, 0, len(documents))
+	for _, doc := range documents {
+		result, err := apply(ctx, resolver, doc)
+		if err != nil {
+			writeResults(banzaiCli, results)
+			return errors.WrapIff(err, "failed to apply %s", doc)
+		}
+
+		results = append(results, result)
+	}
+
+	writeResults(banzaiCli, results)
+
+	return nil
+}
+
+// plan compares a document with the live state and returns the action needed to apply it.
+func plan(ctx context.Context, handler resourceHandler, doc Document) (Result, map[string]interface{}, error) {
+	result := Result{Kind: doc.Kind, Name: doc.Name, Cluster: doc.Cluster}
+
+	current, err := handler.current(ctx, doc)
+	if err != nil {
+		return result, nil, err
+	}
+
+	if current == nil {
+		result.Action = ActionCreate
+		return result, nil, nil
+	}
+
+	desired, err := handler.desired(ctx, doc)
+	if err != nil {
+		return result, current, err
+	}
+
+	changes := changedFields(desired, current)
+	ignorer, _ := handler.(changeIgnorer)
+	for _, change := range changes {
+		if ignorer != nil && ignorer.ignored(change) {
+			result.Ignored = append(result.Ignored, change)
+		} else {
+			result.Changes = append(result.Changes, change)
+		}
+	}
+
+	switch {
+	case len(result.Changes) > 0:
+		result.Action = ActionUpdate
+	case len(result.Ignored) > 0:
+		result.Action = ActionSkipped
+	default:
+		result.Action = ActionUnchanged
+	}
+
+	return result, current, nil
+}
+
+func apply(ctx context.Context, resolver *resolver, doc Document) (Result, error) {
+	handler := resolver.handler(doc.Kind)
+
+	result, _, err := plan(ctx, handler, doc)
+	if err != nil {
+		return result, err
+	}
+
+	if len(result.Ignored) > 0 {
+		log.Warnf("%s differs from the live state in fields which are not updated by applying it: %s", doc, strings.Join(result.Ignored, ", "))
+	}
+
+	switch result.Action {
+	case ActionCreate:
+		log.Debugf("creating %s", doc)
+		err = handler.create(ctx, doc)
+		if err == nil && doc.Kind == KindCluster && resolver.wait != nil && resolver.wait.Enabled() {
+			err = resolver.waitForCluster(doc.Name)
+		}
+	case ActionUpdate:
+		log.Debugf("updating %s: %s", doc, strings.Join(result.Changes, ", "))
+		err = handler.update(ctx, doc, result.Changes)
+	}
+
+	return result, err
+}
+
+type resultRow struct {
+	Kind    string
+	Name    string
+	Cluster string
+	Action  string
+	Changes string
+}
+
+func writeResults(banzaiCli cli.Cli, results []Result) {
+	if len(results) == 0 {
+		return
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"Kind", "Name", "Cluster", "Action", "Changes"},
+	}
+
+	var data interface{} = results
+	if ctx.Format == output.OutputFormatDefault {
+		rows := make([]resultRow, 0, len(results))
+		for _, r := range results {
+			rows = append(rows, resultRow{
+				Kind:    r.Kind,
+				Name:    r.Name,
+				Cluster: r.Cluster,
+				Action:  r.Action,
+				Changes: strings.Join(r.Changes, ", "),
+			})
+		}
+		data = rows
+	}
+
+	if err := output.Output(ctx, data); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"emperror.dev/errors"
+)
+
+// toMap converts a value to its JSON representation as a map.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to marshal")
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, errors.WrapIf(err, "failed to unmarshal")
+	}
+
+	return m, nil
+}
+
+// fromMap converts a map to a typed value through its JSON representation.
+func fromMap(m map[string]interface{}, v interface{}) error {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal")
+	}
+
+	return errors.WrapIf(json.Unmarshal(raw, v), "failed to unmarshal")
+}
+
+// FieldChange describes a field of a document that differs from the live state.
+type FieldChange struct {
+	Path    string      `json:"path"`
+	Current interface{} `json:"current"`
+	Desired interface{} `json:"desired"`
+}
+
+// changedFields returns the paths of the fields of desired that differ from current.
+// Fields missing from desired are not compared, so the live state may contain additional fields.
+func changedFields(desired, current map[string]interface{}) []string {
+	var paths []string
+	for _, change := range fieldChanges(desired, current) {
+		paths = append(paths, change.Path)
+	}
+
+	return paths
+}
+
+// fieldChanges returns the fields of desired that differ from current, sorted by path.
+func fieldChanges(desired, current map[string]interface{}) []FieldChange {
+	var changes []FieldChange
+	collectChanges("", normalize(desired), normalize(current), &changes)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+func collectChanges(prefix string, desired, current interface{}, changes *[]FieldChange) {
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		if !reflect.DeepEqual(desired, current) {
+			*changes = append(*changes, FieldChange{Path: prefix, Current: current, Desired: desired})
+		}
+
+		return
+	}
+
+	currentMap, _ := current.(map[string]interface{})
+	for key, value := range desiredMap {
+		path := key
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, key)
+		}
+
+		currentValue, ok := currentMap[key]
+		if !ok {
+			if !isZero(value) {
+				*changes = append(*changes, FieldChange{Path: path, Desired: value})
+			}
+			continue
+		}
+
+		collectChanges(path, value, currentValue, changes)
+	}
+}
+
+// project returns the parts of current that are present in desired, so the live state can be shown next to a document.
+func project(desired, current interface{}) interface{} {
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		return current
+	}
+
+	currentMap, ok := current.(map[string]interface{})
+	if !ok {
+		return current
+	}
+
+	projected := make(map[string]interface{}, len(desiredMap))
+	for key, value := range desiredMap {
+		if currentValue, ok := currentMap[key]; ok {
+			projected[key] = project(value, currentValue)
+		}
+	}
+
+	return projected
+}
+
+// normalize converts a value to the generic form produced by encoding/json, so values from different sources can be compared.
+func normalize(v interface{}) interface{} {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var n interface{}
+	if err := json.Unmarshal(raw, &n); err != nil {
+		return v
+	}
+
+	return n
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const redacted = "<redacted>"
+
+type diffOptions struct {
+	files []string
+}
+
+// DiffResult describes the difference between a document and the live state.
+type DiffResult struct {
+	Result
+	Fields []FieldChange `json:"fields,omitempty"`
+
+	current map[string]interface{}
+	desired map[string]interface{}
+}
+
+// NewDiffCommand returns a cobra command for showing what applying resource descriptors would change.
+func NewDiffCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := diffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show what applying resource descriptors would change",
+		Long: "Compare the documents accepted by the apply command with the live state of the resources, without changing anything.\n\n" +
+			"Only the fields present in the documents are compared. The differences are shown as a unified diff " +
+			"(lines starting with - are the live state, lines starting with + are the documents), " +
+			"or as a list of changed fields with --output json or yaml. Secret values are not shown.\n\n" +
+			"The descriptors of the cluster update and nodepool update commands can be compared with their --dry-run flag.",
+		Example: `  banzai diff -f cluster.yaml
+  banzai diff -f nodepools/ -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDiff(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringArrayVarP(&options.files, "file", "f", nil, "Document file or directory to compare (\"-\" for stdin), can be repeated")
+
+	return cmd
+}
+
+func runDiff(banzaiCli cli.Cli, options diffOptions) error {
+	if len(options.files) == 0 {
+		return errors.New("at least one --file must be specified")
+	}
+
+	documents, err := ReadDocuments(options.files)
+	if err != nil {
+		return err
+	}
+
+	resolver := newResolver(banzaiCli)
+	ctx := context.Background()
+
+	results := make([]DiffResult, 0, len(documents))
+	for _, doc := range documents {
+		result, err := diff(ctx, resolver, doc)
+		if err != nil {
+			return errors.WrapIff(err, "failed to compare %s", doc)
+		}
+
+		results = append(results, result)
+	}
+
+	return writeDiffResults(banzaiCli, results)
+}
+
+// WriteDescriptorDiff writes the difference between an update descriptor and the live state of a resource the same way as the diff command.
+// Only the fields present in the descriptor are compared.
+func WriteDescriptorDiff(banzaiCli cli.Cli, kind, name, cluster string, desired, current interface{}) error {
+	desiredMap, err := toMap(desired)
+	if err != nil {
+		return errors.WrapIf(err, "invalid descriptor")
+	}
+
+	currentMap, err := toMap(current)
+	if err != nil {
+		return errors.WrapIf(err, "invalid live state")
+	}
+
+	result := DiffResult{
+		Result:  Result{Kind: kind, Name: name, Cluster: cluster, Action: ActionUnchanged},
+		Fields:  fieldChanges(desiredMap, currentMap),
+		current: currentMap,
+		desired: desiredMap,
+	}
+
+	for _, field := range result.Fields {
+		result.Action = ActionUpdate
+		result.Changes = append(result.Changes, field.Path)
+	}
+
+	return writeDiffResults(banzaiCli, []DiffResult{result})
+}
+
+func writeDiffResults(banzaiCli cli.Cli, results []DiffResult) error {
+	if format := banzaiCli.OutputFormat(); format != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: format,
+		}
+
+		return output.Output(ctx, results)
+	}
+
+	changed := 0
+	for _, result := range results {
+		if len(result.Ignored) > 0 {
+			log.Warnf("%s %s differs from the live state in fields which are not updated by applying it: %s", result.Kind, result.Name, strings.Join(result.Ignored, ", "))
+		}
+
+		if result.Action == ActionUnchanged || result.Action == ActionSkipped {
+			log.Debugf("%s %s is %s", result.Kind, result.Name, result.Action)
+			continue
+		}
+
+		changed++
+		if err := writeDiff(banzaiCli, result); err != nil {
+			return errors.WrapIf(err, "failed to write diff")
+		}
+	}
+
+	if changed == 0 {
+		log.Info("no changes")
+	}
+
+	return nil
+}
+
+func diff(ctx context.Context, resolver *resolver, doc Document) (DiffResult, error) {
+	handler := resolver.handler(doc.Kind)
+
+	result, current, err := plan(ctx, handler, doc)
+	if err != nil {
+		return DiffResult{}, err
+	}
+
+	desired, err := handler.desired(ctx, doc)
+	if err != nil {
+		return DiffResult{}, err
+	}
+
+	diffResult := DiffResult{
+		Result:  result,
+		Fields:  fieldChanges(desired, current),
+		current: current,
+		desired: desired,
+	}
+
+	if doc.Kind == KindSecret {
+		for i := range diffResult.Fields {
+			diffResult.Fields[i].Current = redactValue(diffResult.Fields[i].Path, diffResult.Fields[i].Current)
+			diffResult.Fields[i].Desired = redactValue(diffResult.Fields[i].Path, diffResult.Fields[i].Desired)
+		}
+	}
+
+	return diffResult, nil
+}
+
+func writeDiff(banzaiCli cli.Cli, result DiffResult) error {
+	desired := normalize(result.desired)
+
+	var current interface{}
+	if result.current != nil {
+		current = project(desired, normalize(result.current))
+	}
+
+	if result.Kind == KindSecret {
+		desired = redactSecret(desired)
+		current = redactSecret(current)
+	}
+
+	from, err := toYAML(current)
+	if err != nil {
+		return err
+	}
+
+	to, err := toYAML(desired)
+	if err != nil {
+		return err
+	}
+
+	name := result.Name
+	if result.Cluster != "" {
+		name = result.Cluster + "/" + name
+	}
+
+	fromName := fmt.Sprintf("live/%s/%s", result.Kind, name)
+	if result.current == nil {
+		fromName = "/dev/null"
+	}
+
+	return writeUnifiedDiff(banzaiCli.Out(), fromName, fmt.Sprintf("desired/%s/%s", result.Kind, name), from, to, banzaiCli.Color())
+}
+
+func toYAML(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+
+	raw, err := yaml.Marshal(v)
+
+	return string(raw), errors.WrapIf(err, "failed to marshal to YAML")
+}
+
+// redactSecret replaces the values of a secret spec, so they are not printed.
+func redactSecret(spec interface{}) interface{} {
+	specMap, ok := spec.(map[string]interface{})
+	if !ok {
+		return spec
+	}
+
+	values, ok := specMap["values"].(map[string]interface{})
+	if !ok {
+		return spec
+	}
+
+	redactedValues := make(map[string]interface{}, len(values))
+	for key := range values {
+		redactedValues[key] = redacted
+	}
+
+	redactedSpec := make(map[string]interface{}, len(specMap))
+	for key, value := range specMap {
+		redactedSpec[key] = value
+	}
+	redactedSpec["values"] = redactedValues
+
+	return redactedSpec
+}
+
+// redactValue redacts a changed field of a secret spec if it contains secret values.
+func redactValue(path string, value interface{}) interface{} {
+	switch {
+	case value == nil:
+		return nil
+	case path == "values":
+		return redactSecret(map[string]interface{}{"values": value}).(map[string]interface{})["values"]
+	case strings.HasPrefix(path, "values."):
+		return redacted
+	default:
+		return value
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/ghodss/yaml"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// Kinds of the resources that can be described in a document.
+const (
+	KindSecret            = "Secret"
+	KindBucket            = "Bucket"
+	KindCluster           = "Cluster"
+	KindNodePool          = "NodePool"
+	KindIntegratedService = "IntegratedService"
+)
+
+// kindOrder is the order in which the resources are applied, so dependencies exist before the resources referencing them.
+var kindOrder = []string{KindSecret, KindBucket, KindCluster, KindNodePool, KindIntegratedService}
+
+var documentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// Document describes a single Pipeline resource.
+//
+// Spec holds the same descriptor the corresponding create command accepts.
+// Cluster is the name of the cluster for cluster scoped resources (NodePool and IntegratedService).
+type Document struct {
+	Kind    string                 `json:"kind" yaml:"kind"`
+	Name    string                 `json:"name" yaml:"name"`
+	Cluster string                 `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+	Spec    map[string]interface{} `json:"spec" yaml:"spec"`
+
+	source string
+}
+
+// String returns a short human readable identifier of the document.
+func (d Document) String() string {
+	if d.Cluster != "" {
+		return d.Kind + " " + d.Cluster + "/" + d.Name
+	}
+
+	return d.Kind + " " + d.Name
+}
+
+// ReadDocuments reads documents from files, directories (*.yaml, *.yml and *.json files) or the standard input ("-").
+// The returned documents are validated and sorted in dependency order.
+func ReadDocuments(paths []string) ([]Document, error) {
+	var documents []Document
+
+	for _, path := range paths {
+		files := []string{path}
+
+		if path != "-" {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, errors.WrapIfWithDetails(err, "failed to read", "path", path)
+			}
+
+			if info.IsDir() {
+				if files, err = listDescriptorFiles(path); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for _, file := range files {
+			filename, raw, err := utils.ReadFileOrStdin(file)
+			if err != nil {
+				return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+			}
+
+			docs, err := ParseDocuments(raw)
+			if err != nil {
+				return nil, errors.WithDetails(err, "filename", filename)
+			}
+
+			for i := range docs {
+				docs[i].source = filename
+			}
+
+			documents = append(documents, docs...)
+		}
+	}
+
+	SortDocuments(documents)
+
+	return documents, nil
+}
+
+func listDescriptorFiles(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list directory", "path", dir)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+			if !entry.IsDir() {
+				files = append(files, filepath.Join(dir, entry.Name()))
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// ParseDocuments parses multi-document YAML (or a single JSON document) and validates the documents.
+func ParseDocuments(raw []byte) ([]Document, error) {
+	var documents []Document
+
+	for i, part := range documentSeparator.Split(string(raw), -1) {
+		if strings.TrimSpace(stripComments(part)) == "" {
+			continue
+		}
+
+		var doc Document
+		if err := utils.Unmarshal([]byte(part), &doc); err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to parse document", "document", i+1)
+		}
+
+		if err := normalizeDocument(&doc); err != nil {
+			return nil, errors.WithDetails(err, "document", i+1)
+		}
+
+		documents = append(documents, doc)
+	}
+
+	return documents, nil
+}
+
+// WriteDocuments writes documents as multi-document YAML, which can be read back with ReadDocuments.
+func WriteDocuments(out io.Writer, documents []Document) error {
+	for i, doc := range documents {
+		raw, err := yaml.Marshal(doc)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to marshal document", "document", doc.String())
+		}
+
+		if i > 0 {
+			if _, err := fmt.Fprintln(out, "---"); err != nil {
+				return errors.WrapIf(err, "failed to write documents")
+			}
+		}
+
+		if _, err := out.Write(raw); err != nil {
+			return errors.WrapIf(err, "failed to write documents")
+		}
+	}
+
+	return nil
+}
+
+func stripComments(s string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(s, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "#") {
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+func normalizeDocument(doc *Document) error {
+	if kindRank(doc.Kind) < 0 {
+		return errors.Errorf("unknown kind %q, must be one of %s", doc.Kind, strings.Join(kindOrder, ", "))
+	}
+
+	if doc.Spec == nil {
+		doc.Spec = make(map[string]interface{})
+	}
+
+	if doc.Name == "" {
+		doc.Name, _ = doc.Spec["name"].(string)
+	}
+
+	if doc.Name == "" {
+		return errors.Errorf("%s document must have a name", doc.Kind)
+	}
+
+	switch doc.Kind {
+	case KindNodePool, KindIntegratedService:
+		if doc.Cluster == "" {
+			return errors.Errorf("%s document %q must have a cluster", doc.Kind, doc.Name)
+		}
+	default:
+		if doc.Cluster != "" {
+			return errors.Errorf("%s document %q can not have a cluster", doc.Kind, doc.Name)
+		}
+	}
+
+	if doc.Kind != KindIntegratedService {
+		if name, ok := doc.Spec["name"]; ok && name != doc.Name {
+			return errors.Errorf("%s document %q has a different name in its spec: %v", doc.Kind, doc.Name, name)
+		}
+
+		doc.Spec["name"] = doc.Name
+	}
+
+	return nil
+}
+
+// SortDocuments sorts documents in dependency order, keeping the original order of documents of the same kind.
+func SortDocuments(documents []Document) {
+	sort.SliceStable(documents, func(i, j int) bool {
+		return kindRank(documents[i].Kind) < kindRank(documents[j].Kind)
+	})
+}
+
+func kindRank(kind string) int {
+	for i, k := range kindOrder {
+		if k == kind {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,283 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseDocuments(t *testing.T) {
+	raw := []byte(`# cluster with a node pool
+kind: NodePool
+cluster: demo
+spec:
+  name: pool1
+  size: 3
+---
+kind: Secret
+name: aws
+spec:
+  type: amazon
+---
+# empty document
+---
+kind: Cluster
+name: demo
+spec:
+  cloud: amazon
+`)
+
+	documents, err := ParseDocuments(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SortDocuments(documents)
+
+	var names []string
+	for _, doc := range documents {
+		names = append(names, doc.String())
+	}
+
+	expected := []string{"Secret aws", "Cluster demo", "NodePool demo/pool1"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+
+	if documents[0].Spec["name"] != "aws" {
+		t.Errorf("expected the spec name to be set, got %v", documents[0].Spec["name"])
+	}
+}
+
+func TestParseDocumentsInvalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown kind":        "kind: Pod\nname: nginx\n",
+		"missing name":        "kind: Secret\nspec:\n  type: amazon\n",
+		"missing cluster":     "kind: NodePool\nname: pool1\n",
+		"unexpected cluster":  "kind: Bucket\nname: bucket\ncluster: demo\n",
+		"different spec name": "kind: Secret\nname: aws\nspec:\n  name: gcp\n",
+	}
+
+	for name, raw := range tests {
+		if _, err := ParseDocuments([]byte(raw)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	desired := map[string]interface{}{
+		"name":   "pool1",
+		"size":   3,
+		"labels": map[string]interface{}{"team": "a"},
+		"image":  "",
+	}
+	current := map[string]interface{}{
+		"name":         "pool1",
+		"size":         float64(2),
+		"labels":       map[string]interface{}{"team": "a", "node.banzaicloud.io/ondemand": "true"},
+		"instanceType": "m5.large",
+	}
+
+	changes := changedFields(desired, current)
+	if expected := []string{"size"}; !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %v, got %v", expected, changes)
+	}
+
+	desired["labels"] = map[string]interface{}{"team": "b"}
+	changes = changedFields(desired, current)
+	if expected := []string{"labels.team", "size"}; !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %v, got %v", expected, changes)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	a := []string{"name: pool1", "size: 2", "image: ami-1"}
+	b := []string{"name: pool1", "size: 3", "image: ami-1", "spotPrice: \"0.2\""}
+
+	var ops []string
+	for _, line := range diffLines(a, b) {
+		ops = append(ops, string(line.op)+line.text)
+	}
+
+	expected := []string{" name: pool1", "-size: 2", "+size: 3", " image: ami-1", "+spotPrice: \"0.2\""}
+	if !reflect.DeepEqual(ops, expected) {
+		t.Errorf("expected %q, got %q", expected, ops)
+	}
+}
+
+func TestSecretRefs(t *testing.T) {
+	spec := map[string]interface{}{
+		"clusterDomain": "example.org",
+		"providers": []interface{}{
+			map[string]interface{}{"name": "route53", "secretId": "a1b2"},
+		},
+	}
+
+	exported := ReplaceSecretIDs(spec, map[string]string{"a1b2": "aws"})
+	expected := map[string]interface{}{
+		"clusterDomain": "example.org",
+		"providers": []interface{}{
+			map[string]interface{}{"name": "route53", "secretName": "aws"},
+		},
+	}
+	if !reflect.DeepEqual(exported, expected) {
+		t.Errorf("expected %v, got %v", expected, exported)
+	}
+
+	resolved, err := resolveSecretNames(exported, map[string]string{"aws": "c3d4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id := resolved["providers"].([]interface{})[0].(map[string]interface{})["secretId"]; id != "c3d4" {
+		t.Errorf("expected the secret name to be resolved, got %v", id)
+	}
+
+	if _, err := resolveSecretNames(exported, nil); err == nil {
+		t.Error("expected an error for an unknown secret")
+	}
+
+	cluster := map[string]interface{}{"name": "demo", "cloud": "amazon", "secretName": "aws"}
+	resolved, err = resolveSecretNames(cluster, map[string]string{"aws": "c3d4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := resolved["secretName"]; ok || resolved["secretId"] != "c3d4" {
+		t.Errorf("expected the cluster secret name to be resolved, got %v", resolved)
+	}
+}
+
+func TestClusterDesired(t *testing.T) {
+	doc := Document{
+		Kind: KindCluster,
+		Name: "demo",
+		Spec: map[string]interface{}{
+			"name":     "demo",
+			"cloud":    "amazon",
+			"location": "eu-west-1",
+			"secretId": "1234",
+			"properties": map[string]interface{}{
+				"eks": map[string]interface{}{
+					"version": "1.17",
+					"nodePools": map[string]interface{}{
+						"pool1": map[string]interface{}{"instanceType": "t2.medium", "count": 3.0, "spotPrice": "0.1", "autoscaling": false},
+						"pool2": map[string]interface{}{"instanceType": "m5.large", "count": 1.0, "minCount": 1.0, "maxCount": 5.0, "autoscaling": true, "subnet": map[string]interface{}{"subnetId": "s-1"}},
+					},
+				},
+			},
+		},
+	}
+
+	desired, err := clusterHandler{}.desired(context.Background(), doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"name":     "demo",
+		"cloud":    "amazon",
+		"location": "eu-west-1",
+		"version":  "1.17",
+		"nodePools": map[string]interface{}{
+			"pool1": map[string]interface{}{"instanceType": "t2.medium", "count": 3.0, "spotPrice": "0.1", "autoscaling": false},
+			"pool2": map[string]interface{}{"instanceType": "m5.large", "minCount": 1.0, "maxCount": 5.0, "autoscaling": true},
+		},
+	}
+
+	if !reflect.DeepEqual(desired, expected) {
+		t.Errorf("expected %v, got %v", expected, desired)
+	}
+
+	current := map[string]interface{}{
+		"name":     "demo",
+		"cloud":    "amazon",
+		"location": "eu-west-1",
+		"version":  "1.17",
+		"nodePools": map[string]interface{}{
+			"pool1": map[string]interface{}{"instanceType": "t2.large", "count": 3.0, "spotPrice": "0.1", "vcpu": 2.0},
+			"pool2": map[string]interface{}{"instanceType": "m5.large", "count": 4.0, "minCount": 1.0, "maxCount": 5.0, "autoscaling": true},
+		},
+	}
+
+	if changes := changedFields(desired, current); !reflect.DeepEqual(changes, []string{"nodePools.pool1.instanceType"}) {
+		t.Errorf("unexpected changes %v", changes)
+	}
+}
+
+func TestClusterNodePoolsList(t *testing.T) {
+	spec := map[string]interface{}{
+		"nodepools": []interface{}{
+			map[string]interface{}{"name": "master", "instanceType": "Standard_D2s_v3"},
+			map[string]interface{}{"name": "worker", "instanceType": "Standard_D4s_v3"},
+		},
+	}
+
+	nodePools := clusterNodePools(spec)
+	if len(nodePools) != 2 || nodePools["worker"]["instanceType"] != "Standard_D4s_v3" {
+		t.Errorf("unexpected node pools %v", nodePools)
+	}
+}
+
+// stubClusterHandler returns fixed live and desired states for a Cluster document.
+type stubClusterHandler struct {
+	clusterHandler
+	live, spec map[string]interface{}
+}
+
+func (h stubClusterHandler) current(context.Context, Document) (map[string]interface{}, error) {
+	return h.live, nil
+}
+
+func (h stubClusterHandler) desired(context.Context, Document) (map[string]interface{}, error) {
+	return h.spec, nil
+}
+
+func TestPlanIgnoredChanges(t *testing.T) {
+	doc := Document{Kind: KindCluster, Name: "demo"}
+	current := map[string]interface{}{
+		"name":      "demo",
+		"version":   "1.17",
+		"nodePools": map[string]interface{}{"pool1": map[string]interface{}{"instanceType": "t2.large"}},
+	}
+
+	desired := map[string]interface{}{
+		"name":      "demo",
+		"version":   "1.17",
+		"nodePools": map[string]interface{}{"pool1": map[string]interface{}{"instanceType": "t2.medium"}},
+	}
+
+	result, _, err := plan(context.Background(), stubClusterHandler{live: current, spec: desired}, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Action != ActionSkipped || len(result.Changes) != 0 || !reflect.DeepEqual(result.Ignored, []string{"nodePools.pool1.instanceType"}) {
+		t.Errorf("expected the node pool change to be skipped, got %+v", result)
+	}
+
+	desired["version"] = "1.18"
+	result, _, err = plan(context.Background(), stubClusterHandler{live: current, spec: desired}, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Action != ActionUpdate || !reflect.DeepEqual(result.Changes, []string{"version"}) {
+		t.Errorf("expected only the version to be updated, got %+v", result)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+type nodePoolHandler struct {
+	*resolver
+}
+
+func (h nodePoolHandler) current(ctx context.Context, doc Document) (map[string]interface{}, error) {
+	cluster, err := h.findCluster(ctx, doc.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// resources of a cluster that does not exist yet are missing too
+	if cluster == nil {
+		return nil, nil
+	}
+
+	nodePools, _, err := h.client().ClustersApi.ListNodePools(ctx, h.orgID, cluster.Id)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list node pools")
+	}
+
+	for _, np := range nodePools {
+		if np.Name != doc.Name {
+			continue
+		}
+
+		current, err := toMap(np)
+		if err != nil {
+			return nil, err
+		}
+
+		delete(current, "status")
+		delete(current, "statusMessage")
+
+		return current, nil
+	}
+
+	return nil, nil
+}
+
+func (h nodePoolHandler) desired(_ context.Context, doc Document) (map[string]interface{}, error) {
+	return doc.Spec, nil
+}
+
+func (h nodePoolHandler) create(ctx context.Context, doc Document) error {
+	clusterID, err := h.runningClusterID(ctx, doc.Cluster)
+	if err != nil {
+		return err
+	}
+
+	var request pipeline.CreateNodePoolRequest
+	if err := fromMap(doc.Spec, &request); err != nil {
+		return errors.WrapIf(err, "invalid node pool spec")
+	}
+
+	_, err = h.client().ClustersApi.CreateNodePool(ctx, h.orgID, clusterID, request)
+
+	return errors.WrapIf(err, "failed to create node pool")
+}
+
+func (h nodePoolHandler) update(ctx context.Context, doc Document, changes []string) error {
+	if err := onlyChanged(doc, changes,
+		"size", "labels", "autoscaling", "volumeEncryption", "volumeSize", "volumeType",
+		"instanceType", "image", "spotPrice", "securityGroups", "useInstanceStore",
+	); err != nil {
+		return err
+	}
+
+	clusterID, err := h.runningClusterID(ctx, doc.Cluster)
+	if err != nil {
+		return err
+	}
+
+	var request pipeline.UpdateNodePoolRequest
+	if err := fromMap(doc.Spec, &request); err != nil {
+		return errors.WrapIf(err, "invalid node pool spec")
+	}
+
+	response, _, err := h.client().ClustersApi.UpdateNodePool(ctx, h.orgID, clusterID, doc.Name, request)
+	if err != nil {
+		return errors.WrapIf(err, "failed to update node pool")
+	}
+
+	log.Infof("%s is being updated, follow the progress with `banzai process tail %s`", doc, response.ProcessId)
+
+	return nil
+}
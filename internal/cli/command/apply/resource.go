@@ -0,0 +1,194 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
+)
+
+// resourceHandler reads and writes the Pipeline resources of a kind.
+type resourceHandler interface {
+	// current returns the live state of the resource in the form of a document spec, or nil if the resource does not exist.
+	current(ctx context.Context, doc Document) (map[string]interface{}, error)
+
+	// desired returns the part of the document spec that is compared with the live state.
+	desired(ctx context.Context, doc Document) (map[string]interface{}, error)
+
+	create(ctx context.Context, doc Document) error
+
+	// update updates an existing resource, changes contains the paths of the changed fields.
+	update(ctx context.Context, doc Document, changes []string) error
+}
+
+// changeIgnorer is implemented by resource handlers which compare fields of the live state that applying does not change.
+type changeIgnorer interface {
+	// ignored returns whether a change of the field with the given path is not applied.
+	ignored(path string) bool
+}
+
+// resolver looks up resources referenced by documents.
+type resolver struct {
+	banzaiCli cli.Cli
+	orgID     int32
+
+	// wait is used to wait for clusters which are being created or updated, waiting is disabled if nil
+	wait *wait.Options
+}
+
+func newResolver(banzaiCli cli.Cli) *resolver {
+	return &resolver{
+		banzaiCli: banzaiCli,
+		orgID:     banzaiCli.Context().OrganizationID(),
+	}
+}
+
+func (r *resolver) client() *pipeline.APIClient {
+	return r.banzaiCli.Client()
+}
+
+func (r *resolver) handler(kind string) resourceHandler {
+	switch kind {
+	case KindSecret:
+		return secretHandler{r}
+	case KindBucket:
+		return bucketHandler{r}
+	case KindCluster:
+		return clusterHandler{r}
+	case KindNodePool:
+		return nodePoolHandler{r}
+	case KindIntegratedService:
+		return integratedServiceHandler{r}
+	default:
+		return nil
+	}
+}
+
+// findCluster returns the cluster with the given name, or nil if it does not exist.
+func (r *resolver) findCluster(ctx context.Context, name string) (*pipeline.GetClusterStatusResponse, error) {
+	clusters, _, err := r.client().ClustersApi.ListClusters(ctx, r.orgID)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list clusters")
+	}
+
+	for _, c := range clusters {
+		if c.Name == name {
+			cluster := c
+			return &cluster, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// secretIDs returns the IDs of the secrets of the organization by their names.
+func (r *resolver) secretIDs(ctx context.Context) (map[string]string, error) {
+	secrets, _, err := r.client().SecretsApi.GetSecrets(ctx, r.orgID, nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list secrets")
+	}
+
+	ids := make(map[string]string, len(secrets))
+	for _, s := range secrets {
+		ids[s.Name] = s.Id
+	}
+
+	return ids, nil
+}
+
+// runningClusterID returns the ID of a cluster that can be modified.
+// If the cluster is being created or updated, for example by a Cluster document applied before, it waits until the cluster is running.
+func (r *resolver) runningClusterID(ctx context.Context, name string) (int32, error) {
+	cluster, err := r.findCluster(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	if cluster == nil {
+		return 0, errors.Errorf("cluster %q not found", name)
+	}
+
+	if isClusterRunning(cluster.Status) {
+		return cluster.Id, nil
+	}
+
+	if r.wait == nil || !isClusterInProgress(cluster.Status) {
+		return 0, errors.Errorf("cluster %q is %s, apply again when it is running", name, strings.ToLower(cluster.Status))
+	}
+
+	return cluster.Id, r.waitForCluster(name)
+}
+
+// waitForCluster waits until a cluster which is being created or updated is running.
+func (r *resolver) waitForCluster(name string) error {
+	return r.wait.Until(fmt.Sprintf("cluster %q to be running", name), func(ctx context.Context) (bool, error) {
+		cluster, err := r.findCluster(ctx, name)
+		if err != nil {
+			return false, err
+		}
+
+		switch {
+		case cluster == nil:
+			return false, wait.Failed("cluster %q has been deleted", name)
+		case isClusterRunning(cluster.Status):
+			return true, nil
+		case isClusterInProgress(cluster.Status):
+			log.Debugf("cluster %q is %s", name, strings.ToLower(cluster.Status))
+			return false, nil
+		default:
+			return false, wait.Failed("cluster %q is %s: %s", name, strings.ToLower(cluster.Status), cluster.StatusMessage)
+		}
+	})
+}
+
+func isClusterRunning(status string) bool {
+	return status == "RUNNING" || status == "WARNING"
+}
+
+func isClusterInProgress(status string) bool {
+	return status == "CREATING" || status == "UPDATING" || status == "PENDING"
+}
+
+// onlyChanged returns an error if changes contains other fields than the allowed ones.
+func onlyChanged(doc Document, changes []string, allowed ...string) error {
+	var immutable []string
+	for _, change := range changes {
+		ok := false
+		for _, a := range allowed {
+			if change == a || strings.HasPrefix(change, a+".") {
+				ok = true
+				break
+			}
+		}
+
+		if !ok {
+			immutable = append(immutable, change)
+		}
+	}
+
+	if len(immutable) > 0 {
+		return errors.Errorf("%s can not be updated in place, changed fields: %s", doc, strings.Join(immutable, ", "))
+	}
+
+	return nil
+}
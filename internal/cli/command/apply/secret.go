@@ -0,0 +1,111 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+type secretHandler struct {
+	*resolver
+}
+
+func (h secretHandler) find(ctx context.Context, name string) (*pipeline.SecretItem, error) {
+	secrets, _, err := h.client().SecretsApi.GetSecrets(ctx, h.orgID, &pipeline.GetSecretsOpts{Values: optional.NewBool(true)})
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list secrets")
+	}
+
+	for _, s := range secrets {
+		if s.Name == name {
+			secret := s
+			return &secret, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (h secretHandler) current(ctx context.Context, doc Document) (map[string]interface{}, error) {
+	secret, err := h.find(ctx, doc.Name)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	current := map[string]interface{}{
+		"name": secret.Name,
+		"type": secret.Type,
+		"tags": secret.Tags,
+	}
+
+	// values are only compared if Pipeline returns them
+	if len(secret.Values) > 0 {
+		current["values"] = secret.Values
+	}
+
+	return current, nil
+}
+
+func (h secretHandler) desired(_ context.Context, doc Document) (map[string]interface{}, error) {
+	desired := make(map[string]interface{}, len(doc.Spec))
+	for k, v := range doc.Spec {
+		desired[k] = v
+	}
+
+	return desired, nil
+}
+
+func (h secretHandler) request(doc Document) (pipeline.CreateSecretRequest, error) {
+	var request pipeline.CreateSecretRequest
+	err := fromMap(doc.Spec, &request)
+
+	return request, errors.WrapIf(err, "invalid secret spec")
+}
+
+func (h secretHandler) create(ctx context.Context, doc Document) error {
+	request, err := h.request(doc)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = h.client().SecretsApi.AddSecrets(ctx, h.orgID, request, nil)
+
+	return errors.WrapIf(err, "failed to create secret")
+}
+
+func (h secretHandler) update(ctx context.Context, doc Document, _ []string) error {
+	request, err := h.request(doc)
+	if err != nil {
+		return err
+	}
+
+	secret, err := h.find(ctx, doc.Name)
+	if err != nil {
+		return err
+	}
+
+	if secret == nil {
+		return errors.Errorf("secret %q not found", doc.Name)
+	}
+
+	_, _, err = h.client().SecretsApi.UpdateSecrets(ctx, h.orgID, secret.Id, request, nil)
+
+	return errors.WrapIf(err, "failed to update secret")
+}
@@ -0,0 +1,115 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"emperror.dev/errors"
+)
+
+// Cluster and integrated service specs refer to Pipeline secrets by ID, which differs between organizations.
+// Exported documents refer to them by name instead, and the names are resolved when the documents are applied.
+const (
+	secretIDKey   = "secretId"
+	secretNameKey = "secretName"
+)
+
+// ReplaceSecretIDs returns a copy of spec where every secretId field is replaced with a secretName field.
+// names maps secret IDs to secret names, unknown IDs are left untouched.
+func ReplaceSecretIDs(spec map[string]interface{}, names map[string]string) map[string]interface{} {
+	replaced, _ := replaceSecretRefs(spec, func(m map[string]interface{}) error {
+		id, ok := m[secretIDKey].(string)
+		if !ok {
+			return nil
+		}
+
+		if name, ok := names[id]; ok {
+			delete(m, secretIDKey)
+			m[secretNameKey] = name
+		}
+
+		return nil
+	})
+
+	return replaced
+}
+
+// resolveSecretNames returns a copy of spec where every secretName field is replaced with a secretId field.
+// ids maps secret names to secret IDs.
+func resolveSecretNames(spec map[string]interface{}, ids map[string]string) (map[string]interface{}, error) {
+	return replaceSecretRefs(spec, func(m map[string]interface{}) error {
+		name, ok := m[secretNameKey].(string)
+		if !ok {
+			return nil
+		}
+
+		if _, ok := m[secretIDKey]; ok {
+			return nil
+		}
+
+		id, ok := ids[name]
+		if !ok {
+			return errors.Errorf("secret %q not found", name)
+		}
+
+		delete(m, secretNameKey)
+		m[secretIDKey] = id
+
+		return nil
+	})
+}
+
+// replaceSecretRefs deep copies spec and calls replace on every map in it.
+func replaceSecretRefs(spec map[string]interface{}, replace func(map[string]interface{}) error) (map[string]interface{}, error) {
+	var walk func(v interface{}) (interface{}, error)
+	walk = func(v interface{}) (interface{}, error) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			m := make(map[string]interface{}, len(v))
+			for key, value := range v {
+				copied, err := walk(value)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = copied
+			}
+
+			return m, replace(m)
+		case []interface{}:
+			s := make([]interface{}, 0, len(v))
+			for _, value := range v {
+				copied, err := walk(value)
+				if err != nil {
+					return nil, err
+				}
+				s = append(s, copied)
+			}
+
+			return s, nil
+		default:
+			return v, nil
+		}
+	}
+
+	if spec == nil {
+		return nil, nil
+	}
+
+	replaced, err := walk(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return replaced.(map[string]interface{}), nil
+}
@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+type integratedServiceHandler struct {
+	*resolver
+}
+
+func (h integratedServiceHandler) current(ctx context.Context, doc Document) (map[string]interface{}, error) {
+	cluster, err := h.findCluster(ctx, doc.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// resources of a cluster that does not exist yet are missing too
+	if cluster == nil {
+		return nil, nil
+	}
+
+	services, _, err := h.client().IntegratedServicesApi.ListIntegratedServices(ctx, h.orgID, cluster.Id)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list integrated services")
+	}
+
+	details, ok := services[doc.Name]
+	if !ok || details.Status == "INACTIVE" {
+		return nil, nil
+	}
+
+	if details.Spec == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	return details.Spec, nil
+}
+
+// desired returns the spec of the service with secret names resolved to secret IDs.
+func (h integratedServiceHandler) desired(ctx context.Context, doc Document) (map[string]interface{}, error) {
+	ids, err := h.secretIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := resolveSecretNames(doc.Spec, ids)
+
+	return spec, errors.WrapIff(err, "invalid spec of %s", doc)
+}
+
+func (h integratedServiceHandler) create(ctx context.Context, doc Document) error {
+	clusterID, err := h.runningClusterID(ctx, doc.Cluster)
+	if err != nil {
+		return err
+	}
+
+	spec, err := h.desired(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	request := pipeline.ActivateIntegratedServiceRequest{Spec: spec}
+	_, err = h.client().IntegratedServicesApi.ActivateIntegratedService(ctx, h.orgID, clusterID, doc.Name, request)
+
+	return errors.WrapIf(err, "failed to activate integrated service")
+}
+
+func (h integratedServiceHandler) update(ctx context.Context, doc Document, _ []string) error {
+	clusterID, err := h.runningClusterID(ctx, doc.Cluster)
+	if err != nil {
+		return err
+	}
+
+	spec, err := h.desired(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	request := pipeline.UpdateIntegratedServiceRequest{Spec: spec}
+	_, err = h.client().IntegratedServicesApi.UpdateIntegratedService(ctx, h.orgID, clusterID, doc.Name, request)
+
+	return errors.WrapIf(err, "failed to update integrated service")
+}
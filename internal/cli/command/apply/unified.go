@@ -0,0 +1,135 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	colorReset = "\x1b[0m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorCyan  = "\x1b[36m"
+)
+
+// diffLine is a line of a unified diff, op is one of ' ', '-' and '+'.
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// diffLines computes a line based diff of two texts using their longest common subsequence.
+func diffLines(a, b []string) []diffLine {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+
+	return lines
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
+
+// writeUnifiedDiff writes the diff of two texts with full context, optionally coloured.
+func writeUnifiedDiff(out io.Writer, fromName, toName, from, to string, color bool) error {
+	paint := func(c, s string) string {
+		if !color {
+			return s
+		}
+
+		return c + s + colorReset
+	}
+
+	if _, err := fmt.Fprintf(out, "%s\n%s\n", paint(colorRed, "--- "+fromName), paint(colorGreen, "+++ "+toName)); err != nil {
+		return err
+	}
+
+	a, b := splitLines(from), splitLines(to)
+	if _, err := fmt.Fprintln(out, paint(colorCyan, fmt.Sprintf("@@ -%d,%d +%d,%d @@", min(1, len(a)), len(a), min(1, len(b)), len(b)))); err != nil {
+		return err
+	}
+
+	for _, line := range diffLines(a, b) {
+		text := string(line.op) + line.text
+		switch line.op {
+		case '-':
+			text = paint(colorRed, text)
+		case '+':
+			text = paint(colorGreen, text)
+		}
+
+		if _, err := fmt.Fprintln(out, text); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,97 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+// NewBucketCommand returns a cobra command for `backup bucket` subcommands.
+func NewBucketCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bucket",
+		Aliases: []string{"buckets", "b"},
+		Short:   "Manage backup buckets",
+		Long:    "Manage the object storage buckets used by the backup service to store backups.",
+	}
+
+	cmd.AddCommand(
+		newCreateCommand(banzaiCli),
+		newListCommand(banzaiCli),
+		newGetCommand(banzaiCli),
+		newDeleteCommand(banzaiCli),
+		newSyncCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// getBucketID returns the backup bucket ID from the arguments, or asks the user to select a bucket in interactive mode.
+func getBucketID(banzaiCli cli.Cli, args []string, message string) (int32, error) {
+	if len(args) > 0 {
+		id, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return 0, errors.WrapIf(err, "failed to parse bucket ID")
+		}
+
+		return int32(id), nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return 0, errors.New("BUCKET_ID argument must be specified")
+	}
+
+	orgID := banzaiCli.Context().OrganizationID()
+	buckets, _, err := banzaiCli.Client().ArkBucketsApi.ListBackupBuckets(context.Background(), orgID)
+	if err != nil {
+		return 0, errors.WrapIf(err, "failed to list backup buckets")
+	}
+
+	if len(buckets) == 0 {
+		return 0, errors.New("there are no backup buckets in the organization")
+	}
+
+	bucketOptions := make([]string, len(buckets))
+	bucketIDs := make(map[string]int32, len(buckets))
+	for i, b := range buckets {
+		bucketOptions[i] = fmt.Sprintf("%s (%s, %d)", b.Name, b.Cloud, b.Id)
+		bucketIDs[bucketOptions[i]] = b.Id
+	}
+
+	var selected string
+	if err := input.DoQuestions([]input.QuestionMaker{
+		input.QuestionSelect{
+			QuestionInput: input.QuestionInput{
+				QuestionBase: input.QuestionBase{
+					Message: message,
+				},
+				Output: &selected,
+			},
+			Options: bucketOptions,
+		},
+	}); err != nil {
+		return 0, errors.WrapIf(err, "failed to select backup bucket")
+	}
+
+	return bucketIDs[selected], nil
+}
@@ -0,0 +1,195 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type createOptions struct {
+	filePath       string
+	name           string
+	cloud          string
+	secretID       string
+	prefix         string
+	location       string
+	storageAccount string
+	resourceGroup  string
+}
+
+func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [NAME]",
+		Aliases: []string{"c", "add"},
+		Short:   "Register a bucket for storing backups",
+		Long:    "Register an existing object storage bucket to be used by the backup service. The bucket is accessed with the credentials of the given secret.",
+		Example: `  banzai backup bucket create my-backups --cloud amazon --secret-id 0123abcd --location eu-west-1
+  banzai backup bucket create -f bucket.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if len(args) > 0 {
+				options.name = args[0]
+			}
+
+			return runCreate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filePath, "file", "f", "", "Backup bucket creation descriptor file in JSON or YAML format")
+	flags.StringVar(&options.cloud, "cloud", "", "Cloud provider of the bucket")
+	flags.StringVarP(&options.secretID, "secret-id", "s", "", "ID of the secret used to access the bucket")
+	flags.StringVar(&options.prefix, "prefix", "", "Prefix of the backups in the bucket (default: the name of the cluster)")
+	flags.StringVarP(&options.location, "location", "l", "", "Location of the bucket")
+	flags.StringVar(&options.storageAccount, "storage-account", "", "Storage account of the bucket (must be specified for Azure)")
+	flags.StringVar(&options.resourceGroup, "resource-group", "", "Resource group of the bucket (must be specified for Azure)")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var request pipeline.CreateBackupBucketRequest
+	if options.filePath != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.filePath)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return errors.WrapIfWithDetails(err, "failed to unmarshal input", "filename", filename)
+		}
+	} else {
+		if err := completeCreateOptions(banzaiCli, orgID, &options); err != nil {
+			return err
+		}
+
+		request = pipeline.CreateBackupBucketRequest{
+			Cloud:          options.cloud,
+			BucketName:     options.name,
+			SecretId:       options.secretID,
+			Prefix:         options.prefix,
+			Location:       options.location,
+			StorageAccount: options.storageAccount,
+			ResourceGroup:  options.resourceGroup,
+		}
+	}
+
+	if err := validateCreateRequest(request); err != nil {
+		return err
+	}
+
+	bucket, _, err := banzaiCli.Client().ArkBucketsApi.CreateBackupBucket(context.Background(), orgID, request)
+	if err != nil {
+		cli.LogAPIError("create backup bucket", err, request)
+		return errors.WrapIfWithDetails(err, "failed to create backup bucket", "bucket", request.BucketName)
+	}
+
+	log.Infof("Backup bucket %q created with ID %d", bucket.Name, bucket.Id)
+	format.BackupBucketsWrite(banzaiCli, []pipeline.BackupBucketResponse{bucket})
+
+	return nil
+}
+
+func completeCreateOptions(banzaiCli cli.Cli, orgID int32, options *createOptions) error {
+	var err error
+
+	if !banzaiCli.Interactive() {
+		return nil
+	}
+
+	if options.cloud == "" {
+		if options.cloud, err = input.AskCloud(); err != nil {
+			return err
+		}
+	}
+
+	if options.name == "" {
+		err = survey.AskOne(&survey.Input{Message: "Bucket name:"}, &options.name, survey.WithValidator(input.BucketNameValidator(options.cloud)))
+		if err != nil {
+			return errors.WrapIf(err, "failed to get bucket name")
+		}
+	}
+
+	if options.secretID == "" {
+		if options.secretID, err = input.AskSecret(banzaiCli, orgID, options.cloud); err != nil {
+			return err
+		}
+	}
+
+	if options.cloud == input.CloudProviderAzure {
+		if options.storageAccount == "" {
+			err = survey.AskOne(&survey.Input{Message: "Storage account:"}, &options.storageAccount)
+			if err != nil {
+				return errors.WrapIf(err, "failed to get storage account")
+			}
+		}
+
+		if options.resourceGroup == "" {
+			options.resourceGroup, err = input.AskResourceGroup(banzaiCli, orgID, options.secretID, "")
+			if err != nil {
+				return errors.WrapIf(err, "failed to select resource group")
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateCreateRequest(request pipeline.CreateBackupBucketRequest) error {
+	if err := input.IsCloudProviderSupported(request.Cloud); err != nil {
+		return err
+	}
+
+	if request.BucketName == "" {
+		return errors.New("NAME argument must be specified")
+	}
+
+	if err := input.ValidateBucketName(request.Cloud, request.BucketName); err != nil {
+		return errors.WrapIf(err, "failed to validate bucket name")
+	}
+
+	if request.SecretId == "" {
+		return errors.New("--secret-id flag must be specified")
+	}
+
+	if request.Cloud == input.CloudProviderAzure {
+		if request.StorageAccount == "" {
+			return errors.New("--storage-account must be specified for Azure")
+		}
+		if request.ResourceGroup == "" {
+			return errors.New("--resource-group must be specified for Azure")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+func newDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete [BUCKET_ID]",
+		Aliases: []string{"d", "remove", "rm"},
+		Short:   "Delete a backup bucket",
+		Long:    "Delete a backup bucket from Pipeline. Buckets in use by a cluster can not be deleted. The bucket itself and the backups stored in it are kept.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDelete(banzaiCli, args)
+		},
+	}
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	bucketID, err := getBucketID(banzaiCli, args, "Backup bucket to delete")
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Do you want to DELETE the backup bucket %d?", bucketID)}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, _, err := banzaiCli.Client().ArkBucketsApi.DeleteBackupBucket(context.Background(), orgID, bucketID); err != nil {
+		cli.LogAPIError("delete backup bucket", err, bucketID)
+		return errors.WrapIfWithDetails(err, "failed to delete backup bucket", "bucketID", bucketID)
+	}
+
+	log.Infof("Backup bucket %d deleted", bucketID)
+
+	return nil
+}
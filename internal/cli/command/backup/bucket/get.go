@@ -0,0 +1,61 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+func newGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get [BUCKET_ID]",
+		Aliases: []string{"g", "show"},
+		Short:   "Get the details of a backup bucket",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runGet(banzaiCli, args)
+		},
+	}
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	bucketID, err := getBucketID(banzaiCli, args, "Backup bucket")
+	if err != nil {
+		return err
+	}
+
+	bucket, _, err := banzaiCli.Client().ArkBucketsApi.GetBackupBucket(context.Background(), orgID, bucketID)
+	if err != nil {
+		cli.LogAPIError("get backup bucket", err, bucketID)
+		return errors.WrapIfWithDetails(err, "failed to get backup bucket", "bucketID", bucketID)
+	}
+
+	format.BackupBucketWrite(banzaiCli, bucket)
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type listOptions struct {
+	clustercontext.Context
+
+	allClusters bool
+}
+
+type backupRow struct {
+	ID           int32
+	Name         string
+	Cluster      string
+	Cloud        string
+	Distribution string
+	TTL          string
+	ExpireAt     string
+	Status       string
+}
+
+func newListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List backups",
+		Long:    "List the backups of a cluster, or the backups of every cluster of the organization with --all-clusters.",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if options.allClusters {
+				return runListAll(banzaiCli)
+			}
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.allClusters, "all-clusters", false, "List the backups of all clusters of the organization")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list backups of")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if _, err := client.ArkBackupsApi.SyncARKBackupsOfACluster(context.Background(), orgID, clusterID); err != nil {
+		return errors.WrapIfWithDetails(err, "failed to sync cluster backups", "clusterID", clusterID)
+	}
+
+	backups, _, err := client.ArkBackupsApi.ListARKBackupsOfACluster(context.Background(), orgID, clusterID)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to list backups", "clusterID", clusterID)
+	}
+
+	writeBackups(banzaiCli, backups, map[int32]string{clusterID: options.ClusterName()})
+
+	return nil
+}
+
+func runListAll(banzaiCli cli.Cli) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if _, err := client.ArkBackupsApi.SyncOrgBackups(context.Background(), orgID); err != nil {
+		return errors.WrapIf(err, "failed to sync organization backups")
+	}
+
+	backups, _, err := client.ArkBackupsApi.ListARKBackupsForOrganization(context.Background(), orgID)
+	if err != nil {
+		return errors.WrapIf(err, "failed to list backups")
+	}
+
+	clusters, _, err := client.ClustersApi.ListClusters(context.Background(), orgID)
+	if err != nil {
+		return errors.WrapIf(err, "failed to list clusters")
+	}
+
+	clusterNames := make(map[int32]string, len(clusters))
+	for _, c := range clusters {
+		clusterNames[c.Id] = c.Name
+	}
+
+	writeBackups(banzaiCli, backups, clusterNames)
+
+	return nil
+}
+
+func writeBackups(banzaiCli cli.Cli, backups []pipeline.BackupResponse, clusterNames map[int32]string) {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.BackupsWrite(banzaiCli, backups)
+		return
+	}
+
+	table := make([]backupRow, 0, len(backups))
+	for _, b := range backups {
+		cluster, ok := clusterNames[b.ClusterId]
+		if !ok {
+			cluster = fmt.Sprintf("%d (deleted)", b.ClusterId)
+		}
+
+		table = append(table, backupRow{
+			ID:           b.Id,
+			Name:         b.Name,
+			Cluster:      cluster,
+			Cloud:        b.Cloud,
+			Distribution: b.Distribution,
+			TTL:          b.Ttl,
+			ExpireAt:     b.ExpireAt,
+			Status:       b.Status,
+		})
+	}
+
+	format.BackupsWrite(banzaiCli, table)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 )
 
 type createBucketsOptions struct {
@@ -44,7 +45,7 @@ type createBucketsOptions struct {
 	storageAccount string
 	resourceGroup  string
 
-	wait bool
+	wait *wait.Options
 }
 
 // NewCreateCommand creates a new cobra.Command for `banzai bucket create`.
@@ -108,7 +109,7 @@ func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags.StringVarP(&o.secretID, "secret-id", "s", "", "Secret ID of the used secret to create the bucket")
 	flags.StringVarP(&o.storageAccount, "storage-account", "", "", "Storage account for the bucket (must be specified for Azure)")
 	flags.StringVarP(&o.resourceGroup, "resource-group", "", "", "Resource group for the bucket (must be specified for Azure)")
-	flags.BoolVarP(&o.wait, "wait", "w", false, "Wait for bucket creation")
+	o.wait = wait.NewOptions(cmd)
 
 	return cmd
 }
@@ -204,7 +205,10 @@ func runCreate(banzaiCli cli.Cli, o createBucketsOptions) error {
 
 	log.Infof("bucket create request accepted for %s on %s", response.Name, response.Cloud)
 
-	done := !o.wait
+	if !o.wait.Enabled() {
+		return nil
+	}
+
 	var getBucketOpts pipeline.GetBucketOpts
 	if o.cloud == input.CloudProviderAzure {
 		getBucketOpts.Location = optional.NewString(o.location)
@@ -212,22 +216,22 @@ func runCreate(banzaiCli cli.Cli, o createBucketsOptions) error {
 		getBucketOpts.StorageAccount = optional.NewString(o.storageAccount)
 	}
 
-	for !done {
-		log.Info("wait for response")
-		time.Sleep(time.Duration(3) * time.Second)
-		bucket, _, err := banzaiCli.Client().StorageApi.GetBucket(context.Background(), orgID, o.name, o.cloud, &getBucketOpts)
+	return o.wait.Until("bucket creation", func(ctx context.Context) (bool, error) {
+		bucket, _, err := banzaiCli.Client().StorageApi.GetBucket(ctx, orgID, o.name, o.cloud, &getBucketOpts)
 		if err != nil {
-			return errors.WrapIf(utils.ConvertError(errors.WithStack(err)), "could not get bucket")
+			return false, errors.WrapIf(utils.ConvertError(errors.WithStack(err)), "could not get bucket")
 		}
-		if bucket.Status != "CREATING" {
+
+		switch bucket.Status {
+		case "CREATING":
+			return false, nil
+		case "ERROR_CREATE":
+			return false, wait.Failed("bucket creation failed: %s", bucket.StatusMessage)
+		default:
 			format.DetailedBucketWrite(banzaiCli, ConvertBucketInfoToBucket(bucket), bucket.Cloud)
-			done = true
-		} else {
-			time.Sleep(time.Duration(3) * time.Second)
+			return true, nil
 		}
-	}
-
-	return nil
+	})
 }
 
 func getCreateBucketRequest(o createBucketsOptions) pipeline.CreateObjectStoreBucketRequest {
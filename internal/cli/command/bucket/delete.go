@@ -16,6 +16,7 @@ package bucket
 
 import (
 	"context"
+	"net/http"
 
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
@@ -29,6 +30,7 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
 	"github.com/banzaicloud/banzai-cli/internal/cli/output"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 )
 
 type deleteBucketsOptions struct {
@@ -36,6 +38,8 @@ type deleteBucketsOptions struct {
 	cloud          string
 	location       string
 	storageAccount string
+
+	wait *wait.Options
 }
 
 // NewDeleteCommand creates a new cobra.Command for `banzai bucket delete`.
@@ -95,6 +99,7 @@ func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags.StringVarP(&o.cloud, "cloud", "", "", "Cloud provider for the bucket")
 	flags.StringVarP(&o.location, "location", "l", "", "Location (e.g. us-central1) for the bucket")
 	flags.StringVarP(&o.storageAccount, "storage-account", "", "", "Storage account where the bucket resides (must be specified for Azure)")
+	o.wait = wait.NewOptions(cmd)
 
 	return cmd
 }
@@ -134,6 +139,37 @@ func runDelete(banzaiCli cli.Cli, o deleteBucketsOptions) error {
 		return errors.WrapIf(utils.ConvertError(err), "could not delete bucket")
 	}
 
+	if !o.wait.Enabled() {
+		log.Infof("bucket '%s' successfully deleted", bucket.Name)
+		return nil
+	}
+
+	var getBucketOpts pipeline.GetBucketOpts
+	if bucket.Cloud == input.CloudProviderAzure {
+		getBucketOpts.Location = optional.NewString(bucket.Location)
+		getBucketOpts.ResourceGroup = optional.NewString(bucket.ResourceGroup)
+		getBucketOpts.StorageAccount = optional.NewString(bucket.StorageAccount)
+	}
+
+	err = o.wait.Until("bucket deletion", func(ctx context.Context) (bool, error) {
+		info, resp, err := banzaiCli.Client().StorageApi.GetBucket(ctx, orgID, bucket.Name, bucket.Cloud, &getBucketOpts)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.WrapIf(utils.ConvertError(errors.WithStack(err)), "could not get bucket")
+		}
+
+		if info.Status == "ERROR_DELETE" {
+			return false, wait.Failed("bucket deletion failed: %s", info.StatusMessage)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Infof("bucket '%s' successfully deleted", bucket.Name)
 
 	return nil
@@ -21,6 +21,7 @@ import (
 
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -114,12 +115,7 @@ func (c *clusterContext) Init(args ...string) error {
 			return errors.New("no cluster is selected; use the --cluster or --cluster-name option, or set the cluster.id config value")
 		}
 
-		clusterSlice := make([]string, len(clusters))
-		for i, cluster := range clusters {
-			clusterSlice[i] = cluster.Name
-		}
-
-		err := survey.AskOne(&survey.Select{Message: "Cluster:", Options: clusterSlice}, &c.name, survey.WithValidator(survey.Required))
+		err := survey.AskOne(&survey.Select{Message: "Cluster:", Options: clusterNames(clusters)}, &c.name, survey.WithValidator(survey.Required))
 		if err != nil {
 			return errors.WrapIf(err, "failed to select a cluster")
 		}
@@ -134,3 +130,60 @@ func (c *clusterContext) Init(args ...string) error {
 	}
 	return errors.Errorf("could not find cluster named %q", c.name)
 }
+
+// SelectClusters returns the IDs of the named clusters. If no names are given, the user is asked to pick
+// clusters interactively, with the clusters named in defaults preselected.
+func SelectClusters(banzaiCli cli.Cli, names []string, defaults []string) ([]int32, error) {
+	client := banzaiCli.Client()
+	orgId := banzaiCli.Context().OrganizationID()
+
+	clusters, _, err := client.ClustersApi.ListClusters(context.Background(), orgId)
+	if err != nil {
+		return nil, errors.WrapIf(err, "could not list clusters")
+	}
+
+	if len(clusters) == 0 {
+		return nil, errors.New("there are no clusters in the organization")
+	}
+
+	if len(names) == 0 {
+		if !banzaiCli.Interactive() {
+			return nil, errors.New("no clusters are selected")
+		}
+
+		err := survey.AskOne(&survey.MultiSelect{Message: "Clusters:", Options: clusterNames(clusters), Default: defaults}, &names, survey.WithValidator(survey.Required))
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to select clusters")
+		}
+	}
+
+	ids := make([]int32, 0, len(names))
+	for _, name := range names {
+		id, ok := findCluster(clusters, name)
+		if !ok {
+			return nil, errors.Errorf("could not find cluster named %q", name)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func findCluster(clusters []pipeline.GetClusterStatusResponse, name string) (int32, bool) {
+	for _, cluster := range clusters {
+		if cluster.Name == name {
+			return cluster.Id, true
+		}
+	}
+
+	return 0, false
+}
+
+func clusterNames(clusters []pipeline.GetClusterStatusResponse) []string {
+	names := make([]string, len(clusters))
+	for i, cluster := range clusters {
+		names[i] = cluster.Name
+	}
+
+	return names
+}
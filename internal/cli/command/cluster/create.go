@@ -39,14 +39,15 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 )
 
 type createOptions struct {
 	file     string
 	name     string
-	wait     bool
 	interval int
 	template string
+	wait     *wait.Options
 }
 
 // NewCreateCommand creates a new cobra.Command for `banzai cluster create`.
@@ -67,10 +68,10 @@ func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	flags := cmd.Flags()
 
+	options.wait = wait.NewOptions(cmd)
 	flags.StringVarP(&options.file, "file", "f", "", "Cluster descriptor file")
 	flags.StringVar(&options.name, "name", "", "Cluster name (overrides name defined in the descriptor)")
-	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for cluster creation")
-	flags.IntVarP(&options.interval, "interval", "i", 10, "Interval in seconds for polling cluster status")
+	flags.IntVarP(&options.interval, "interval", "i", 10, "Initial interval in seconds for polling cluster status")
 	flags.StringVarP(&options.template, "template", "t", "", "Cluster template for creation (use keys: pke-on-aws, pke-on-azure, aks, gke, eks)")
 
 	return cmd
@@ -120,42 +121,60 @@ func runCreate(banzaiCli cli.Cli, options createOptions) error {
 	}
 
 	log.Info("cluster is being created")
-	if options.wait {
-		for {
-			cluster, _, err := banzaiCli.Client().ClustersApi.GetCluster(context.Background(), orgID, cluster.Id)
-			if err != nil {
-				openAPIError, ok := err.(pipeline.GenericOpenAPIError)
-				if !ok {
-					cli.LogAPIError("create cluster", err, out)
-				}
+	if !options.wait.Enabled() {
+		log.Infof("you can check its status with the command `banzai cluster get %q`", out["name"])
+		format.ClusterShortWrite(banzaiCli, cluster)
+		return nil
+	}
 
-				var commonError pipeline.CommonError
-				unmarshalErr := json.Unmarshal(openAPIError.Body(), &commonError)
-				if unmarshalErr != nil {
-					cli.LogAPIError("create cluster", err, out)
-				}
+	options.wait.SetInterval(time.Duration(options.interval) * time.Second)
 
-				if commonError.Code == http.StatusConflict &&
-					commonError.Message == "Secret has not been created yet" &&
-					commonError.Error == "cluster is not ready" {
-					log.Info("cluster is being created")
-				} else {
-					cli.LogAPIError("create cluster", err, out)
-				}
-			} else {
-				format.ClusterShortWrite(banzaiCli, cluster)
-				if cluster.Status != "CREATING" {
-					return nil
-				}
+	return waitForCluster(banzaiCli, orgID, cluster.Id, "cluster creation", "CREATING", options.wait)
+}
 
-				time.Sleep(time.Duration(options.interval) * time.Second)
+// waitForCluster waits until the cluster leaves the given status, and writes the cluster details when it does.
+func waitForCluster(banzaiCli cli.Cli, orgID int32, clusterID int32, description string, status string, waitOptions *wait.Options) error {
+	return waitOptions.Until(description, func(ctx context.Context) (bool, error) {
+		cluster, _, err := banzaiCli.Client().ClustersApi.GetCluster(ctx, orgID, clusterID)
+		if err != nil {
+			if isSecretNotCreatedError(err) {
+				log.Debug("cluster is being created")
+				return false, nil
 			}
+
+			cli.LogAPIError("get cluster", err, clusterID)
+			return false, errors.WrapIf(err, "failed to get cluster")
 		}
-	} else {
-		log.Infof("you can check its status with the command `banzai cluster get %q`", out["name"])
-		format.ClusterShortWrite(banzaiCli, cluster)
+
+		switch cluster.Status {
+		case status:
+			log.Debugf("cluster is %s", strings.ToLower(status))
+			return false, nil
+		case "ERROR":
+			format.ClusterShortWrite(banzaiCli, cluster)
+			return false, wait.Failed("%s failed: %s", description, cluster.StatusMessage)
+		default:
+			format.ClusterShortWrite(banzaiCli, cluster)
+			return true, nil
+		}
+	})
+}
+
+// isSecretNotCreatedError returns whether the error is returned for a cluster that is being created.
+func isSecretNotCreatedError(err error) bool {
+	openAPIError, ok := err.(pipeline.GenericOpenAPIError)
+	if !ok {
+		return false
 	}
-	return nil
+
+	var commonError pipeline.CommonError
+	if err := json.Unmarshal(openAPIError.Body(), &commonError); err != nil {
+		return false
+	}
+
+	return commonError.Code == http.StatusConflict &&
+		commonError.Message == "Secret has not been created yet" &&
+		commonError.Error == "cluster is not ready"
 }
 
 func validateClusterCreateRequest(val interface{}) error {
@@ -16,6 +16,7 @@ package cluster
 
 import (
 	"context"
+	"net/http"
 
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
@@ -24,12 +25,14 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 type deleteOptions struct {
 	force bool
+	wait  *wait.Options
 	clustercontext.Context
 }
 
@@ -49,6 +52,7 @@ func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.force, "force", "f", false, "Allow non-graceful cluster deletion")
+	options.wait = wait.NewOptions(cmd)
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete")
 
 	return cmd
@@ -81,10 +85,36 @@ func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
 		log.Info("deleting cluster")
 		log.Debug(resp)
 	}
-	if cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgId, id); err != nil {
-		cli.LogAPIError("get cluster", err, id)
-	} else {
-		format.ClusterWrite(banzaiCli, cluster)
+	if !options.wait.Enabled() {
+		if cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgId, id); err != nil {
+			cli.LogAPIError("get cluster", err, id)
+		} else {
+			format.ClusterWrite(banzaiCli, cluster)
+		}
+		return nil
 	}
+
+	err := options.wait.Until("cluster deletion", func(ctx context.Context) (bool, error) {
+		cluster, resp, err := client.ClustersApi.GetCluster(ctx, orgId, id)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return true, nil
+		}
+		if err != nil {
+			cli.LogAPIError("get cluster", err, id)
+			return false, errors.WrapIf(err, "failed to get cluster")
+		}
+
+		if cluster.Status == "ERROR" {
+			return false, wait.Failed("cluster deletion failed: %s", cluster.StatusMessage)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("cluster deleted")
+
 	return nil
 }
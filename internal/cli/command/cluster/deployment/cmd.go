@@ -0,0 +1,115 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/base64"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewDeploymentCommand returns a cobra command for `deployment` subcommands.
+func NewDeploymentCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deployment",
+		Aliases: []string{"deployments", "deploy"},
+		Short:   "Manage Helm deployments",
+	}
+
+	cmd.AddCommand(
+		newListCommand(banzaiCli),
+		newGetCommand(banzaiCli),
+		newCreateCommand(banzaiCli),
+		newUpgradeCommand(banzaiCli),
+		newDeleteCommand(banzaiCli),
+		newStatusCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// getReleaseName returns the release name from the arguments, or asks the user to select one in interactive mode.
+func getReleaseName(banzaiCli cli.Cli, orgID, clusterID int32, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return "", errors.New("RELEASE argument must be specified")
+	}
+
+	deployments, _, err := banzaiCli.Client().DeploymentsApi.ListDeployments(context.Background(), orgID, clusterID, &pipeline.ListDeploymentsOpts{})
+	if err != nil {
+		return "", errors.WrapIfWithDetails(err, "failed to list deployments", "clusterID", clusterID)
+	}
+
+	if len(deployments) == 0 {
+		return "", errors.New("there are no deployments on the cluster")
+	}
+
+	releaseNames := make([]string, len(deployments))
+	for i, d := range deployments {
+		releaseNames[i] = d.ReleaseName
+	}
+
+	var releaseName string
+	if err := input.DoQuestions([]input.QuestionMaker{
+		input.QuestionSelect{
+			QuestionInput: input.QuestionInput{
+				QuestionBase: input.QuestionBase{
+					Message: "Deployment:",
+				},
+				Output: &releaseName,
+			},
+			Options: releaseNames,
+		},
+	}); err != nil {
+		return "", errors.WrapIf(err, "failed to select deployment")
+	}
+
+	return releaseName, nil
+}
+
+// readValues reads Helm values in JSON or YAML format from a file or from the standard input.
+func readValues(filePath string) (map[string]interface{}, error) {
+	filename, raw, err := utils.ReadFileOrStdin(filePath)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+	}
+
+	values := make(map[string]interface{})
+	if err := utils.Unmarshal(raw, &values); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to unmarshal values", "filename", filename)
+	}
+
+	return values, nil
+}
+
+// decodeNotes decodes the base64 encoded deployment notes returned by Pipeline.
+func decodeNotes(notes string) string {
+	decoded, err := base64.StdEncoding.DecodeString(notes)
+	if err != nil {
+		return notes
+	}
+
+	return string(decoded)
+}
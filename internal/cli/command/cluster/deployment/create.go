@@ -0,0 +1,134 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type createOptions struct {
+	clustercontext.Context
+
+	releaseName string
+	namespace   string
+	version     string
+	valuesFile  string
+	dryRun      bool
+	wait        bool
+	timeout     int64
+}
+
+func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create CHART",
+		Aliases: []string{"c", "install"},
+		Short:   "Deploy a Helm chart to the cluster",
+		Long:    "Deploy a Helm chart (for example stable/nginx-ingress) to the cluster. Values can be given in a JSON or YAML file, or on the standard input.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runCreate(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.releaseName, "release-name", "", "Name of the release (generated if not specified)")
+	flags.StringVarP(&options.namespace, "namespace", "n", "", "Namespace to deploy the chart to")
+	flags.StringVar(&options.version, "version", "", "Chart version (the latest version is used if not specified)")
+	flags.StringVarP(&options.valuesFile, "file", "f", "", "Values file of the chart (use - to read from the standard input)")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Simulate the deployment")
+	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait until all resources of the deployment are ready")
+	flags.Int64Var(&options.timeout, "timeout", 0, "Time in seconds to wait for any individual Kubernetes operation")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "deploy to")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, chart string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	request := pipeline.CreateUpdateDeploymentRequest{
+		Name:        chart,
+		Version:     options.version,
+		Namespace:   options.namespace,
+		ReleaseName: options.releaseName,
+		DryRun:      options.dryRun,
+		Wait:        options.wait,
+		Timeout:     options.timeout,
+	}
+
+	if options.valuesFile != "" {
+		values, err := readValues(options.valuesFile)
+		if err != nil {
+			return errors.WrapIf(err, "failed to read values")
+		}
+		request.Values = values
+	}
+
+	log.Debugf("create deployment request: %#v", request)
+
+	response, _, err := client.DeploymentsApi.CreateDeployment(context.Background(), orgID, clusterID, request)
+	if err != nil {
+		cli.LogAPIError("create deployment", err, request)
+		return errors.WrapIfWithDetails(err, "failed to create deployment", "clusterID", clusterID, "chart", chart)
+	}
+
+	writeCreateUpdateResponse(banzaiCli, response, "deployed")
+
+	return nil
+}
+
+func writeCreateUpdateResponse(banzaiCli cli.Cli, response pipeline.CreateUpdateDeploymentResponse, verb string) {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		if err := output.SingleOutput(ctx, response); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
+	log.Infof("deployment %q is being %s", response.ReleaseName, verb)
+
+	if response.Notes != "" {
+		_, _ = fmt.Fprintf(banzaiCli.Out(), "NOTES:\n%s\n", decodeNotes(response.Notes))
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+func newDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [RELEASE]",
+		Aliases: []string{"del", "rm", "uninstall"},
+		Short:   "Delete a Helm deployment",
+		Long:    "Delete a Helm deployment from the cluster. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete deployment of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterID, args)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		deployment, _, err := client.DeploymentsApi.GetDeployment(context.Background(), orgID, clusterID, releaseName, &pipeline.GetDeploymentOpts{})
+		if err != nil {
+			cli.LogAPIError("get deployment", err, releaseName)
+			return errors.WrapIfWithDetails(err, "failed to get deployment", "clusterID", clusterID, "release", releaseName)
+		}
+
+		format.DeploymentWrite(banzaiCli, deployment)
+
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the deployment?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	response, _, err := client.DeploymentsApi.DeleteDeployment(context.Background(), orgID, clusterID, releaseName)
+	if err != nil {
+		cli.LogAPIError("delete deployment", err, releaseName)
+		return errors.WrapIfWithDetails(err, "failed to delete deployment", "clusterID", clusterID, "release", releaseName)
+	}
+
+	log.Debugf("delete deployment response: %#v", response)
+	log.Infof("deployment %q deleted", releaseName)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type getOptions struct {
+	clustercontext.Context
+}
+
+func newGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get [RELEASE]",
+		Aliases: []string{"g", "show"},
+		Short:   "Get details of a Helm deployment",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runGet(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get deployment of")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterID, args)
+	if err != nil {
+		return err
+	}
+
+	deployment, _, err := client.DeploymentsApi.GetDeployment(context.Background(), orgID, clusterID, releaseName, &pipeline.GetDeploymentOpts{})
+	if err != nil {
+		cli.LogAPIError("get deployment", err, releaseName)
+		return errors.WrapIfWithDetails(err, "failed to get deployment", "clusterID", clusterID, "release", releaseName)
+	}
+
+	format.DeploymentWrite(banzaiCli, deployment)
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault && deployment.Notes != "" {
+		_, _ = fmt.Fprintf(banzaiCli.Out(), "\nNOTES:\n%s\n", decodeNotes(deployment.Notes))
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type listOptions struct {
+	clustercontext.Context
+
+	tag string
+}
+
+func newListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List Helm deployments of the cluster",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.tag, "tag", "", "Filter deployments by tag")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list deployments of")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	listOpts := pipeline.ListDeploymentsOpts{}
+	if options.tag != "" {
+		listOpts.Tag = optional.NewString(options.tag)
+	}
+
+	deployments, _, err := client.DeploymentsApi.ListDeployments(context.Background(), orgID, clusterID, &listOpts)
+	if err != nil {
+		cli.LogAPIError("list deployments", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to list deployments", "clusterID", clusterID)
+	}
+
+	format.DeploymentsWrite(banzaiCli, deployments)
+
+	return nil
+}
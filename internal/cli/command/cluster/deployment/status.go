@@ -0,0 +1,112 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"net/http"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const (
+	deploymentStatusOK       = "OK"
+	deploymentStatusNotFound = "NOT FOUND"
+	deploymentStatusError    = "ERROR"
+)
+
+type statusOptions struct {
+	clustercontext.Context
+}
+
+func newStatusCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := statusOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "status [RELEASE]",
+		Aliases: []string{"s"},
+		Short:   "Check the status of a Helm deployment",
+		Long:    "Check the status of a Helm deployment. The command exits with a non-zero code if the deployment is not healthy.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runStatus(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "check deployment of")
+
+	return cmd
+}
+
+func runStatus(banzaiCli cli.Cli, options statusOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterID, args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeploymentsApi.HelmDeploymentStatus(context.Background(), orgID, clusterID, releaseName)
+	if resp == nil {
+		return errors.WrapIfWithDetails(err, "failed to check deployment status", "clusterID", clusterID, "release", releaseName)
+	}
+
+	var status string
+	switch resp.StatusCode {
+	case http.StatusOK:
+		status = deploymentStatusOK
+	case http.StatusNotFound:
+		status = deploymentStatusNotFound
+	default:
+		status = deploymentStatusError
+	}
+
+	type row struct {
+		ReleaseName string
+		Status      string
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"ReleaseName", "Status"},
+	}
+
+	if err := output.SingleOutput(ctx, row{ReleaseName: releaseName, Status: status}); err != nil {
+		log.Fatal(err)
+	}
+
+	if status != deploymentStatusOK {
+		return errors.Errorf("deployment %q is not healthy: %s", releaseName, resp.Status)
+	}
+
+	return nil
+}
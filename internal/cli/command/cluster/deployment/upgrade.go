@@ -0,0 +1,204 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type upgradeOptions struct {
+	clustercontext.Context
+
+	chart       string
+	version     string
+	valuesFile  string
+	reuseValues bool
+	dryRun      bool
+	wait        bool
+	timeout     int64
+}
+
+func newUpgradeCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := upgradeOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "upgrade [RELEASE]",
+		Aliases: []string{"u", "update"},
+		Short:   "Upgrade a Helm deployment",
+		Long: "Upgrade a Helm deployment to a new chart version or with new values.\n\n" +
+			"If --chart is not specified, the deployed chart is looked up in the Helm repositories of the organization, " +
+			"and the deployed chart version is kept unless --version is specified, so the values can be changed alone.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runUpgrade(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.chart, "chart", "", "Chart of the deployment, for example stable/nginx-ingress (default: the deployed chart)")
+	flags.StringVar(&options.version, "version", "", "Chart version (default: the deployed version if --chart is not specified, the latest version otherwise)")
+	flags.StringVarP(&options.valuesFile, "file", "f", "", "Values file of the chart (use - to read from the standard input)")
+	flags.BoolVar(&options.reuseValues, "reuse-values", false, "Reuse the values of the current release and merge the given values into them")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Simulate the upgrade")
+	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait until all resources of the deployment are ready")
+	flags.Int64Var(&options.timeout, "timeout", 0, "Time in seconds to wait for any individual Kubernetes operation")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "upgrade deployment of")
+
+	return cmd
+}
+
+func runUpgrade(banzaiCli cli.Cli, options upgradeOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterID, args)
+	if err != nil {
+		return err
+	}
+
+	current, _, err := client.DeploymentsApi.GetDeployment(context.Background(), orgID, clusterID, releaseName, &pipeline.GetDeploymentOpts{})
+	if err != nil {
+		cli.LogAPIError("get deployment", err, releaseName)
+		return errors.WrapIfWithDetails(err, "failed to get deployment", "clusterID", clusterID, "release", releaseName)
+	}
+
+	chart, version := options.chart, options.version
+	if chart == "" {
+		if chart, err = deployedChart(banzaiCli, orgID, current); err != nil {
+			return err
+		}
+
+		if version == "" {
+			version = current.ChartVersion
+		}
+
+		log.Debugf("upgrading the deployed chart %s %s", chart, version)
+	}
+
+	request := pipeline.CreateUpdateDeploymentRequest{
+		Name:        chart,
+		Version:     version,
+		Namespace:   current.Namespace,
+		ReleaseName: releaseName,
+		ReuseValues: options.reuseValues,
+		DryRun:      options.dryRun,
+		Wait:        options.wait,
+		Timeout:     options.timeout,
+	}
+
+	if options.valuesFile != "" {
+		values, err := readValues(options.valuesFile)
+		if err != nil {
+			return errors.WrapIf(err, "failed to read values")
+		}
+		request.Values = values
+	}
+
+	log.Debugf("upgrade deployment request: %#v", request)
+
+	response, _, err := client.DeploymentsApi.UpdateDeployment(context.Background(), orgID, clusterID, releaseName, request)
+	if err != nil {
+		cli.LogAPIError("upgrade deployment", err, request)
+		return errors.WrapIfWithDetails(err, "failed to upgrade deployment", "clusterID", clusterID, "release", releaseName)
+	}
+
+	writeCreateUpdateResponse(banzaiCli, response, "upgraded")
+
+	return nil
+}
+
+// deployedChart returns the REPO/CHART reference of the chart of a deployment.
+// Deployments only record the name of their chart, so it is looked up in the Helm repositories of the organization.
+func deployedChart(banzaiCli cli.Cli, orgID int32, deployment pipeline.GetDeploymentResponse) (string, error) {
+	if deployment.ChartName == "" {
+		return "", errors.New("the chart of the deployment is unknown, specify it with --chart")
+	}
+
+	listOpts := pipeline.HelmChartListOpts{
+		Name:    optional.NewString(deployment.ChartName),
+		Version: optional.NewString(deployment.ChartVersion),
+	}
+
+	response, _, err := banzaiCli.Client().HelmApi.HelmChartList(context.Background(), orgID, &listOpts)
+	if err != nil {
+		cli.LogAPIError("list Helm charts", err, listOpts)
+		return "", errors.WrapIf(err, "failed to look up the chart of the deployment")
+	}
+
+	repos, err := chartRepos(response, deployment.ChartName)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(repos) {
+	case 0:
+		return "", errors.Errorf("chart %s %s of the deployment is not found in the Helm repositories of the organization, specify it with --chart", deployment.ChartName, deployment.ChartVersion)
+	case 1:
+		return repos[0] + "/" + deployment.ChartName, nil
+	default:
+		return "", errors.Errorf("chart %s of the deployment is found in several Helm repositories (%s), specify it with --chart", deployment.ChartName, strings.Join(repos, ", "))
+	}
+}
+
+// chartRepos returns the repositories of a chart list response which contain the chart with the given name.
+func chartRepos(response []map[string]interface{}, name string) ([]string, error) {
+	// the API returns untyped items, convert them through JSON
+	raw, err := json.Marshal(response)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to marshal Helm chart list")
+	}
+
+	var entries []struct {
+		Repo   string `json:"repo"`
+		Charts [][]struct {
+			Name string `json:"name"`
+		} `json:"charts"`
+	}
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, errors.WrapIf(err, "failed to parse Helm chart list")
+	}
+
+	var repos []string
+	for _, entry := range entries {
+		for _, versions := range entry.Charts {
+			if len(versions) > 0 && versions[0].Name == name {
+				repos = append(repos, entry.Repo)
+				break
+			}
+		}
+	}
+
+	return repos, nil
+}
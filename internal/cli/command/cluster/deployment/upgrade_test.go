@@ -0,0 +1,42 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChartRepos(t *testing.T) {
+	chart := func(name string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "version": "0.7.0"}
+	}
+
+	response := []map[string]interface{}{
+		{"repo": "stable", "charts": []interface{}{[]interface{}{chart("mysql")}, []interface{}{chart("mysqldump")}}},
+		{"repo": "banzaicloud-stable", "charts": []interface{}{[]interface{}{chart("mysqldump")}}},
+		{"repo": "mirror", "charts": []interface{}{[]interface{}{chart("mysql")}}},
+		{"repo": "empty", "charts": []interface{}{}},
+	}
+
+	repos, err := chartRepos(response, "mysql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"stable", "mirror"}; !reflect.DeepEqual(repos, want) {
+		t.Errorf("chartRepos() = %v, want %v", repos, want)
+	}
+}
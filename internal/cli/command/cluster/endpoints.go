@@ -0,0 +1,112 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type endpointsOptions struct {
+	clustercontext.Context
+
+	release string
+}
+
+type endpointRow struct {
+	Name    string `json:"name"`
+	Host    string `json:"host,omitempty"`
+	Service string `json:"service,omitempty"`
+	Url     string `json:"url,omitempty"`
+}
+
+func NewEndpointsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := endpointsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "endpoints [--cluster=ID | [--cluster-name=]NAME]",
+		Aliases: []string{"endpoint", "ep"},
+		Short:   "List the public endpoints of the cluster",
+		Long:    "List the public URLs of the services and ingresses running on the cluster.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runEndpoints(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.release, "deployment", "", "List only the endpoints of the given Helm deployment (release name)")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list endpoints of")
+
+	return cmd
+}
+
+func runEndpoints(banzaiCli cli.Cli, options endpointsOptions, args []string) error {
+	if err := options.Init(args...); err != nil {
+		return errors.WrapIf(err, "failed to initialize options")
+	}
+
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	opts := pipeline.ListClusterEndpointsOpts{}
+	if options.release != "" {
+		opts.ReleaseName = optional.NewString(options.release)
+	}
+
+	response, _, err := banzaiCli.Client().ClustersApi.ListClusterEndpoints(context.Background(), orgID, clusterID, &opts)
+	if err != nil {
+		cli.LogAPIError("list cluster endpoints", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to list cluster endpoints", "clusterID", clusterID)
+	}
+
+	format.EndpointsWrite(banzaiCli, endpointRows(response.Endpoints))
+
+	return nil
+}
+
+// endpointRows flattens endpoints to one row per URL.
+func endpointRows(endpoints []pipeline.EndpointItem) []endpointRow {
+	rows := make([]endpointRow, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if len(endpoint.Urls) == 0 {
+			rows = append(rows, endpointRow{Name: endpoint.Name, Host: endpoint.Host})
+			continue
+		}
+
+		for _, url := range endpoint.Urls {
+			rows = append(rows, endpointRow{
+				Name:    endpoint.Name,
+				Host:    endpoint.Host,
+				Service: url.Servicename,
+				Url:     url.Url,
+			})
+		}
+	}
+
+	return rows
+}
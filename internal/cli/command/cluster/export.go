@@ -0,0 +1,397 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/apply"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type exportOptions struct {
+	clustercontext.Context
+
+	name string
+}
+
+// NewExportCommand creates a new cobra.Command for `banzai cluster export`.
+func NewExportCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := exportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export [--cluster=ID | [--cluster-name=]NAME]",
+		Short: "Export a cluster as a reusable descriptor",
+		Long: "Export the cluster, its node pools and its active integrated services as YAML documents.\n\n" +
+			"The documents can be replayed with `banzai apply -f`, and the spec of the Cluster document is a descriptor " +
+			"accepted by `banzai cluster create --file`. Secret IDs are replaced with secret names, " +
+			"so the documents can be used in other organizations with secrets of the same names.\n\n" +
+			"Some settings (for example the cloud credential secret of the cluster) are not available from the API; " +
+			"they are listed as warnings and must be filled in before replaying the documents.\n\n" +
+			"When the documents are replayed with a new cluster name, apply creates the cluster first, " +
+			"and activates the integrated services once the cluster is running.",
+		Example: `  banzai cluster export --cluster-name staging --name staging-eu > staging-eu.yaml
+  banzai apply -f staging-eu.yaml --timeout 45m`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runExport(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "export")
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.name, "name", "", "Name of the cluster in the exported documents (default: the name of the exported cluster)")
+
+	return cmd
+}
+
+func runExport(banzaiCli cli.Cli, options exportOptions, args []string) error {
+	if err := options.Init(args...); err != nil {
+		return errors.WrapIf(err, "failed to initialize options")
+	}
+
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+	ctx := context.Background()
+
+	cluster, _, err := client.ClustersApi.GetCluster(ctx, orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster", err, clusterID)
+		return errors.WrapIf(err, "failed to get cluster")
+	}
+
+	nodePools, err := exportNodePools(ctx, client, orgID, cluster)
+	if err != nil {
+		return err
+	}
+
+	secrets, _, err := client.SecretsApi.GetSecrets(ctx, orgID, nil)
+	if err != nil {
+		cli.LogAPIError("list secrets", err, orgID)
+		return errors.WrapIf(err, "failed to list secrets")
+	}
+
+	secretNames := make(map[string]string, len(secrets))
+	for _, s := range secrets {
+		secretNames[s.Id] = s.Name
+	}
+
+	services, _, err := client.IntegratedServicesApi.ListIntegratedServices(ctx, orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list integrated services", err, clusterID)
+		return errors.WrapIf(err, "failed to list integrated services")
+	}
+
+	name := cluster.Name
+	if options.name != "" {
+		name = options.name
+	}
+
+	spec, warnings, err := exportClusterSpec(cluster, nodePools)
+	if err != nil {
+		return err
+	}
+
+	spec["name"] = name
+	documents := []apply.Document{{Kind: apply.KindCluster, Name: name, Spec: spec}}
+
+	serviceNames := make([]string, 0, len(services))
+	for serviceName := range services {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		details := services[serviceName]
+		switch details.Status {
+		case "ACTIVE":
+			documents = append(documents, apply.Document{
+				Kind:    apply.KindIntegratedService,
+				Name:    serviceName,
+				Cluster: name,
+				Spec:    apply.ReplaceSecretIDs(details.Spec, secretNames),
+			})
+		case "INACTIVE":
+			// not activated on the cluster
+		default:
+			warnings = append(warnings, "integrated service "+serviceName+" is skipped, because it is "+strings.ToLower(details.Status))
+		}
+	}
+
+	for _, warning := range warnings {
+		log.Warn(warning)
+	}
+
+	return apply.WriteDocuments(banzaiCli.Out(), documents)
+}
+
+// exportedNodePool contains the node pool settings which are available for every distribution.
+type exportedNodePool struct {
+	pipeline.NodePoolStatus
+
+	// summary is only available for EKS clusters
+	summary *pipeline.NodePoolSummary
+}
+
+func exportNodePools(ctx context.Context, client *pipeline.APIClient, orgID int32, cluster pipeline.GetClusterStatusResponse) (map[string]exportedNodePool, error) {
+	labels, _, err := client.ClustersApi.ListNodepoolLabels(ctx, orgID, cluster.Id)
+	if err != nil {
+		cli.LogAPIError("list node pool labels", err, cluster.Id)
+		return nil, errors.WrapIf(err, "failed to list node pool labels")
+	}
+
+	nodePools := make(map[string]exportedNodePool, len(cluster.NodePools))
+	for name, status := range cluster.NodePools {
+		// only user defined labels can be set in a create request
+		status.Labels = nil
+		for _, label := range labels[name] {
+			if label.Reserved {
+				continue
+			}
+
+			if status.Labels == nil {
+				status.Labels = make(map[string]string)
+			}
+			status.Labels[label.Name] = label.Value
+		}
+
+		nodePools[name] = exportedNodePool{NodePoolStatus: status}
+	}
+
+	if cluster.Distribution != "eks" {
+		return nodePools, nil
+	}
+
+	summaries, _, err := client.ClustersApi.ListNodePools(ctx, orgID, cluster.Id)
+	if err != nil {
+		cli.LogAPIError("list node pools", err, cluster.Id)
+		return nil, errors.WrapIf(err, "failed to list node pools")
+	}
+
+	for _, summary := range summaries {
+		summary := summary
+		np := nodePools[summary.Name]
+		np.summary = &summary
+		nodePools[summary.Name] = np
+	}
+
+	return nodePools, nil
+}
+
+// exportClusterSpec builds a cluster create request from the details of a cluster.
+// It also returns the list of settings which could not be exported.
+func exportClusterSpec(cluster pipeline.GetClusterStatusResponse, nodePools map[string]exportedNodePool) (map[string]interface{}, []string, error) {
+	warnings := []string{
+		"the cloud credential secret of the cluster can not be exported, set secretName in the Cluster document",
+	}
+
+	names := make([]string, 0, len(nodePools))
+	for name := range nodePools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	spec := map[string]interface{}{
+		"name":     cluster.Name,
+		"location": cluster.Location,
+	}
+
+	switch {
+	case cluster.Distribution == "eks":
+		pools := make(map[string]interface{}, len(nodePools))
+		for _, name := range names {
+			pools[name] = exportEKSNodePool(nodePools[name])
+		}
+
+		spec["cloud"] = cluster.Cloud
+		spec["properties"] = map[string]interface{}{
+			"eks": map[string]interface{}{"version": cluster.Version, "nodePools": pools},
+		}
+
+	case cluster.Distribution == "gke":
+		pools := make(map[string]interface{}, len(nodePools))
+		for _, name := range names {
+			pools[name] = exportNodePool(nodePools[name].NodePoolStatus)
+		}
+
+		spec["cloud"] = cluster.Cloud
+		spec["properties"] = map[string]interface{}{
+			"gke": map[string]interface{}{
+				"master":      map[string]interface{}{"version": cluster.Version},
+				"nodeVersion": cluster.Version,
+				"nodePools":   pools,
+			},
+		}
+
+	case cluster.Distribution == "aks":
+		pools := make(map[string]interface{}, len(nodePools))
+		for _, name := range names {
+			pools[name] = exportNodePool(nodePools[name].NodePoolStatus)
+		}
+
+		spec["cloud"] = cluster.Cloud
+		spec["properties"] = map[string]interface{}{
+			"aks": map[string]interface{}{"kubernetesVersion": cluster.Version, "nodePools": pools},
+		}
+		warnings = append(warnings, "the resource group of the cluster can not be exported, set properties.aks.resourceGroup in the Cluster document")
+
+	case cluster.Distribution == "pke" && cluster.Cloud == "amazon":
+		pools := make([]interface{}, 0, len(nodePools))
+		for _, name := range names {
+			np := nodePools[name]
+			asg := map[string]interface{}{
+				"instanceType": np.InstanceType,
+				"spotPrice":    np.SpotPrice,
+				"size":         map[string]interface{}{"desired": np.Count, "min": np.MinCount, "max": np.MaxCount},
+			}
+			if np.Image != "" {
+				asg["image"] = np.Image
+			}
+
+			pools = append(pools, map[string]interface{}{
+				"name":           name,
+				"roles":          pkeNodePoolRoles(name),
+				"labels":         np.Labels,
+				"autoscaling":    np.Autoscaling,
+				"provider":       "amazon",
+				"providerConfig": map[string]interface{}{"autoScalingGroup": asg},
+			})
+		}
+
+		spec["cloud"] = cluster.Cloud
+		spec["properties"] = map[string]interface{}{
+			"pke": map[string]interface{}{
+				"kubernetes": map[string]interface{}{"version": cluster.Version, "rbac": map[string]interface{}{"enabled": true}},
+				"cri":        map[string]interface{}{"runtime": "containerd"},
+				"nodePools":  pools,
+			},
+		}
+		warnings = append(warnings, "node pool roles and zones can not be exported, check the node pools in the Cluster document")
+
+	case cluster.Distribution == "pke" && cluster.Cloud == "azure":
+		pools := make([]interface{}, 0, len(nodePools))
+		for _, name := range names {
+			pool := exportNodePool(nodePools[name].NodePoolStatus)
+			pool["name"] = name
+			pool["roles"] = pkeNodePoolRoles(name)
+			pools = append(pools, pool)
+		}
+
+		spec["type"] = pkeOnAzure
+		spec["kubernetes"] = map[string]interface{}{"version": cluster.Version, "rbac": true}
+		spec["nodepools"] = pools
+		warnings = append(warnings,
+			"the resource group of the cluster can not be exported, set resourceGroup in the Cluster document",
+			"node pool roles can not be exported, check the node pools in the Cluster document",
+		)
+
+	case cluster.Distribution == "pke" && cluster.Cloud == "vsphere":
+		pools := make([]interface{}, 0, len(nodePools))
+		for _, name := range names {
+			np := nodePools[name]
+			pools = append(pools, map[string]interface{}{
+				"name":     name,
+				"roles":    pkeNodePoolRoles(name),
+				"labels":   np.Labels,
+				"size":     np.Count,
+				"vcpu":     np.Vcpu,
+				"ram":      np.Ram,
+				"template": np.Template,
+			})
+		}
+
+		delete(spec, "location")
+		spec["type"] = pkeOnVsphere
+		spec["kubernetes"] = map[string]interface{}{"version": cluster.Version, "rbac": true}
+		spec["nodepools"] = pools
+		warnings = append(warnings,
+			"the folder, datastore and resource pool of the cluster can not be exported, set them in the Cluster document",
+			"node pool roles can not be exported, check the node pools in the Cluster document",
+		)
+
+	default:
+		return nil, nil, errors.Errorf("exporting %s clusters on %s is not supported", cluster.Distribution, cluster.Cloud)
+	}
+
+	return spec, warnings, nil
+}
+
+func exportNodePool(np pipeline.NodePoolStatus) map[string]interface{} {
+	pool := map[string]interface{}{
+		"instanceType": np.InstanceType,
+		"count":        np.Count,
+	}
+
+	if np.Autoscaling {
+		pool["autoscaling"] = true
+		pool["minCount"] = np.MinCount
+		pool["maxCount"] = np.MaxCount
+	}
+
+	if len(np.Labels) > 0 {
+		pool["labels"] = np.Labels
+	}
+
+	return pool
+}
+
+func exportEKSNodePool(np exportedNodePool) map[string]interface{} {
+	pool := exportNodePool(np.NodePoolStatus)
+	pool["spotPrice"] = np.SpotPrice
+	pool["minCount"] = np.MinCount
+	pool["maxCount"] = np.MaxCount
+
+	if np.Image != "" {
+		pool["image"] = np.Image
+	}
+
+	if s := np.summary; s != nil {
+		if s.VolumeEncryption != nil {
+			pool["volumeEncryption"] = s.VolumeEncryption
+		}
+
+		if s.VolumeSize > 0 {
+			pool["volumeSize"] = s.VolumeSize
+		}
+
+		if s.UseInstanceStore {
+			pool["useInstanceStore"] = true
+		}
+	}
+
+	return pool
+}
+
+// pkeNodePoolRoles guesses the roles of a PKE node pool from its name, as they are not returned by the API.
+func pkeNodePoolRoles(name string) []string {
+	if strings.Contains(name, "master") {
+		return []string{"master", "worker"}
+	}
+
+	return []string{"worker"}
+}
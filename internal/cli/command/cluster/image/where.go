@@ -0,0 +1,76 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type whereOptions struct {
+	clustercontext.Context
+}
+
+func newWhereCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := whereOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "where DIGEST",
+		Aliases: []string{"w", "deployments"},
+		Short:   "List the deployments using an image",
+		Example: "  banzai cluster image where sha256:93ce9120377effb33fc8ab25cc5fb6ab736982aa4524adb89324c031e47b33ac",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runWhere(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "search")
+
+	return cmd
+}
+
+func runWhere(banzaiCli cli.Cli, options whereOptions, digest string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if !strings.Contains(digest, ":") {
+		digest = "sha256:" + digest
+	}
+
+	deployments, _, err := banzaiCli.Client().ImagesApi.ListDeploymentsByImage(context.Background(), orgID, clusterID, digest)
+	if err != nil {
+		cli.LogAPIError("list deployments by image", err, digest)
+		return errors.WrapIfWithDetails(err, "failed to list deployments", "clusterID", clusterID, "digest", digest)
+	}
+
+	format.DeploymentsWrite(banzaiCli, deployments)
+
+	return nil
+}
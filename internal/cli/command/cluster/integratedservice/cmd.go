@@ -53,7 +53,7 @@ func NewIntegratedServiceCommand(banzaiCli cli.Cli) *cobra.Command {
 		services.NewServiceCommand(banzaiCli, "ingress", ingress.NewManager(banzaiCli)),
 		services.NewServiceCommand(banzaiCli, "logging", logging.NewManager(banzaiCli)),
 		services.NewServiceCommand(banzaiCli, "monitoring", monitoring.NewManager(banzaiCli)),
-		services.NewServiceCommand(banzaiCli, "securityscan", securityscan.NewManager(banzaiCli)),
+		services.NewServiceCommand(banzaiCli, "securityscan", securityscan.NewManager(banzaiCli), securityscan.NewWhitelistCommand(banzaiCli)),
 		services.NewServiceCommand(banzaiCli, "vault", vault.NewManager(banzaiCli)),
 
 		backup.NewBackupCommand(banzaiCli),
@@ -28,11 +28,13 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 )
 
 type activateOptions struct {
 	clustercontext.Context
 	filePath string
+	wait     *wait.Options
 }
 
 type activateManager interface {
@@ -62,6 +64,8 @@ func newActivateCommand(banzaiCLI cli.Cli, use string, mngr activateManager) *co
 	flags := cmd.Flags()
 	flags.StringVarP(&options.filePath, "file", "f", "", "Service specification file")
 
+	options.wait = wait.NewOptions(cmd)
+
 	return cmd
 }
 
@@ -103,7 +107,11 @@ func runActivate(banzaiCLI cli.Cli, m activateManager, options activateOptions,
 
 	log.Infof("service %q started to activate", m.ReadableName())
 
-	return nil
+	if !options.wait.Enabled() {
+		return nil
+	}
+
+	return waitForService(banzaiCLI, orgId, clusterId, m, "activation", "ACTIVE", options.wait)
 }
 
 func readActivateReqFromFileOrStdin(filePath string, req *pipeline.ActivateIntegratedServiceRequest) error {
@@ -16,6 +16,7 @@ package backup
 
 import (
 	"context"
+	"strconv"
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
@@ -42,6 +43,10 @@ func NewBackupCommand(banzaiCli cli.Cli) *cobra.Command {
 		newListCommand(banzaiCli),
 		newCreateCommand(banzaiCli),
 		newDeleteCommand(banzaiCli),
+		newDownloadCommand(banzaiCli),
+		newLogsCommand(banzaiCli),
+		newInspectCommand(banzaiCli),
+		newScheduleCommand(banzaiCli),
 	)
 
 	return cmd
@@ -72,3 +77,26 @@ func isCommandEnabledForCluster(client *pipeline.APIClient, orgID, clusterID int
 		return false, nil
 	}
 }
+
+// getBackupID returns the backup ID from the arguments, or asks the user to select a backup in interactive mode.
+func getBackupID(banzaiCli cli.Cli, orgID, clusterID int32, args []string, message string) (int32, error) {
+	if len(args) > 0 {
+		id, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return 0, errors.WrapIf(err, "failed to parse backupID")
+		}
+
+		return int32(id), nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return 0, errors.New("backup ID argument must be specified")
+	}
+
+	backup, err := askBackup(banzaiCli.Client(), orgID, clusterID, message)
+	if err != nil {
+		return 0, errors.WrapIf(err, "failed to ask backup")
+	}
+
+	return backup.Id, nil
+}
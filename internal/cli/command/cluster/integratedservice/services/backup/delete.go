@@ -82,7 +82,7 @@ func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
 
 	if backupID == 0 {
 		if banzaiCli.Interactive() {
-			backup, err := askBackupToDelete(client, orgID, clusterID)
+			backup, err := askBackup(client, orgID, clusterID, "Backup to delete")
 			if err != nil {
 				return errors.WrapIf(err, "failed to ask backup to delete")
 			}
@@ -103,12 +103,16 @@ func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
 	return nil
 }
 
-func askBackupToDelete(client *pipeline.APIClient, orgID, clusterID int32) (*pipeline.BackupResponse, error) {
+func askBackup(client *pipeline.APIClient, orgID, clusterID int32, message string) (*pipeline.BackupResponse, error) {
 	backups, _, err := client.ArkBackupsApi.ListARKBackupsOfACluster(context.Background(), orgID, clusterID)
 	if err != nil {
 		return nil, errors.WrapIfWithDetails(err, "failed to list backups", "clusterID", clusterID)
 	}
 
+	if len(backups) == 0 {
+		return nil, errors.New("there are no backups of the cluster")
+	}
+
 	backupOptions := make([]string, len(backups))
 	for id, b := range backups {
 		backupOptions[id] = b.Name
@@ -119,7 +123,7 @@ func askBackupToDelete(client *pipeline.APIClient, orgID, clusterID int32) (*pip
 		input.QuestionSelect{
 			QuestionInput: input.QuestionInput{
 				QuestionBase: input.QuestionBase{
-					Message: "Backup to delete",
+					Message: message,
 				},
 				Output: &selectedBackupName,
 			},
@@ -127,7 +131,7 @@ func askBackupToDelete(client *pipeline.APIClient, orgID, clusterID int32) (*pip
 		},
 	})
 	if err != nil {
-		return nil, errors.WrapIf(err, "failed to get bucket to delete")
+		return nil, errors.WrapIf(err, "failed to select backup")
 	}
 
 	var selectedBackup pipeline.BackupResponse
@@ -0,0 +1,131 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type downloadOptions struct {
+	clustercontext.Context
+
+	outputFile string
+}
+
+func newDownloadCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := downloadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "download [BACKUP_ID]",
+		Short: "Download the contents of a backup",
+		Long:  "Download the contents of a backup as a gzipped tarball. Use `-` as output file to write the tarball to the standard output.",
+		Example: `  banzai cluster service backup download 42 --output-file backup.tgz
+  banzai cluster service backup download 42 --output-file - | tar tz`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runDownload(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.outputFile, "output-file", "", "Path of the downloaded tarball (default: backup-<ID>.tgz)")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "download")
+
+	return cmd
+}
+
+func runDownload(banzaiCli cli.Cli, options downloadOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkBackupServiceEnabled(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	backupID, err := getBackupID(banzaiCli, orgID, clusterID, args, "Backup to download")
+	if err != nil {
+		return err
+	}
+
+	contents, err := downloadBackup(client, orgID, clusterID, backupID)
+	if err != nil {
+		return err
+	}
+	defer closeAndRemove(contents)
+
+	outputFile := options.outputFile
+	if outputFile == "" {
+		outputFile = fmt.Sprintf("backup-%d.tgz", backupID)
+	}
+
+	if outputFile == "-" {
+		_, err = io.Copy(banzaiCli.Out(), contents)
+		return errors.WrapIf(err, "failed to write backup contents")
+	}
+
+	out, err := os.Create(outputFile)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to create output file", "filename", outputFile)
+	}
+	defer out.Close()
+
+	size, err := io.Copy(out, contents)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to write backup contents", "filename", outputFile)
+	}
+
+	log.Infof("Backup %d downloaded to %s (%d bytes)", backupID, outputFile, size)
+
+	return nil
+}
+
+// downloadBackup returns the gzipped tarball of a backup in a temporary file.
+func downloadBackup(client *pipeline.APIClient, orgID, clusterID, backupID int32) (*os.File, error) {
+	contents, _, err := client.ArkBackupsApi.DownloadARKBackupContents(context.Background(), orgID, clusterID, backupID)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to download backup", "clusterID", clusterID, "backupID", backupID)
+	}
+
+	if contents == nil {
+		return nil, errors.NewWithDetails("empty backup contents received", "clusterID", clusterID, "backupID", backupID)
+	}
+
+	return contents, nil
+}
+
+func closeAndRemove(f *os.File) {
+	_ = f.Close()
+	_ = os.Remove(f.Name())
+}
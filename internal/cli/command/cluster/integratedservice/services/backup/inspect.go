@@ -0,0 +1,269 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type inspectOptions struct {
+	clustercontext.Context
+
+	filePath string
+}
+
+// backupResource is a Kubernetes resource stored in a backup tarball.
+type backupResource struct {
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Kind      string `json:"kind" yaml:"kind"`
+	Name      string `json:"name" yaml:"name"`
+}
+
+func newInspectCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := inspectOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "inspect [BACKUP_ID]",
+		Short: "List the Kubernetes resources stored in a backup",
+		Long: "List the Kubernetes resources stored in a backup, grouped by namespace and kind. " +
+			"The backup is downloaded to a temporary file, unless a previously downloaded tarball is given with --file.",
+		Example: `  banzai cluster service backup inspect 42
+  banzai cluster service backup inspect --file backup-42.tgz`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if options.filePath != "" {
+				if len(args) > 0 {
+					return errors.New("BACKUP_ID argument and --file flag are mutually exclusive")
+				}
+
+				return runInspectFile(banzaiCli, options.filePath)
+			}
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runInspect(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filePath, "file", "f", "", "Inspect a downloaded backup tarball instead of downloading it")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "inspect")
+
+	return cmd
+}
+
+func runInspect(banzaiCli cli.Cli, options inspectOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkBackupServiceEnabled(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	backupID, err := getBackupID(banzaiCli, orgID, clusterID, args, "Backup to inspect")
+	if err != nil {
+		return err
+	}
+
+	contents, err := downloadBackup(client, orgID, clusterID, backupID)
+	if err != nil {
+		return err
+	}
+	defer closeAndRemove(contents)
+
+	resources, err := readBackupResources(contents)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to read backup contents", "backupID", backupID)
+	}
+
+	return writeBackupResources(banzaiCli, resources)
+}
+
+func runInspectFile(banzaiCli cli.Cli, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to open backup tarball", "filename", filePath)
+	}
+	defer f.Close()
+
+	resources, err := readBackupResources(f)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to read backup contents", "filename", filePath)
+	}
+
+	return writeBackupResources(banzaiCli, resources)
+}
+
+// readBackupResources lists the resources of a Velero backup tarball.
+//
+// Resources are stored as resources/<resource>/namespaces/<namespace>/<name>.json
+// or resources/<resource>/cluster/<name>.json, optionally with an API version directory
+// after the resource directory. Each resource is listed once, sorted by namespace, kind and name.
+func readBackupResources(r io.Reader) ([]backupResource, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to decompress backup")
+	}
+	defer gz.Close()
+
+	seen := make(map[backupResource]bool)
+	resources := make([]backupResource, 0)
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to read backup tarball")
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		resource, ok := parseResourcePath(header.Name)
+		if !ok {
+			continue
+		}
+
+		raw, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to read backup tarball", "filename", header.Name)
+		}
+
+		var object struct {
+			Kind string `json:"kind"`
+		}
+		if err := json.Unmarshal(raw, &object); err == nil && object.Kind != "" {
+			resource.Kind = object.Kind
+		}
+
+		if !seen[resource] {
+			seen[resource] = true
+			resources = append(resources, resource)
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+
+	return resources, nil
+}
+
+// parseResourcePath extracts the resource type, namespace and name from a path in a backup tarball.
+// The kind of the returned resource is the resource type, which should be replaced by the kind of the object.
+func parseResourcePath(name string) (backupResource, bool) {
+	parts := strings.Split(strings.TrimPrefix(path.Clean(name), "./"), "/")
+	if len(parts) < 4 || parts[0] != "resources" || path.Ext(name) != ".json" {
+		return backupResource{}, false
+	}
+
+	resource := backupResource{
+		Kind: parts[1],
+		Name: strings.TrimSuffix(parts[len(parts)-1], ".json"),
+	}
+
+	scope := parts[2 : len(parts)-1]
+	if len(scope) > 0 && scope[0] != "namespaces" && scope[0] != "cluster" {
+		// skip the API version directory
+		scope = scope[1:]
+	}
+
+	switch {
+	case len(scope) == 2 && scope[0] == "namespaces":
+		resource.Namespace = scope[1]
+	case len(scope) == 1 && scope[0] == "cluster":
+	default:
+		return backupResource{}, false
+	}
+
+	return resource, true
+}
+
+func writeBackupResources(banzaiCli cli.Cli, resources []backupResource) error {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return output.Output(ctx, resources)
+	}
+
+	type row struct {
+		Namespace string
+		Kind      string
+		Count     int
+		Names     string
+	}
+
+	table := make([]row, 0)
+	for _, r := range resources {
+		namespace := r.Namespace
+		if namespace == "" {
+			namespace = "(cluster)"
+		}
+
+		if n := len(table); n > 0 && table[n-1].Namespace == namespace && table[n-1].Kind == r.Kind {
+			table[n-1].Count++
+			table[n-1].Names += ", " + r.Name
+			continue
+		}
+
+		table = append(table, row{Namespace: namespace, Kind: r.Kind, Count: 1, Names: r.Name})
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"Namespace", "Kind", "Count", "Names"},
+	}
+
+	return output.Output(ctx, table)
+}
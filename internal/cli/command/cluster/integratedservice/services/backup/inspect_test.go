@@ -0,0 +1,75 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"testing"
+)
+
+func TestReadBackupResources(t *testing.T) {
+	files := []struct {
+		name    string
+		content string
+	}{
+		{name: "metadata/version", content: "1"},
+		{name: "resources/deployments.apps/namespaces/default/web.json", content: `{"kind":"Deployment"}`},
+		{name: "resources/deployments.apps/v1-preferredversion/namespaces/default/web.json", content: `{"kind":"Deployment"}`},
+		{name: "resources/configmaps/namespaces/default/settings.json", content: `{"kind":"ConfigMap"}`},
+		{name: "resources/configmaps/namespaces/default/ca.json", content: `{"kind":"ConfigMap"}`},
+		{name: "resources/configmaps/namespaces/kube-system/coredns.json", content: `{"kind":"ConfigMap"}`},
+		{name: "resources/namespaces/cluster/default.json", content: `{"kind":"Namespace"}`},
+		{name: "resources/widgets.example.com/namespaces/default/w.json", content: `not json`},
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0600, Size: int64(len(f.content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := readBackupResources(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []backupResource{
+		{Kind: "Namespace", Name: "default"},
+		{Namespace: "default", Kind: "ConfigMap", Name: "ca"},
+		{Namespace: "default", Kind: "ConfigMap", Name: "settings"},
+		{Namespace: "default", Kind: "Deployment", Name: "web"},
+		{Namespace: "default", Kind: "widgets.example.com", Name: "w"},
+		{Namespace: "kube-system", Kind: "ConfigMap", Name: "coredns"},
+	}
+
+	if !reflect.DeepEqual(resources, expected) {
+		t.Errorf("unexpected resources\nexpected: %v\nactual:   %v", expected, resources)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type logsOptions struct {
+	clustercontext.Context
+}
+
+func newLogsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := logsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "logs [BACKUP_ID]",
+		Aliases: []string{"log"},
+		Short:   "Show the logs of a backup",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runLogs(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "show logs of")
+
+	return cmd
+}
+
+func runLogs(banzaiCli cli.Cli, options logsOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkBackupServiceEnabled(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	backupID, err := getBackupID(banzaiCli, orgID, clusterID, args, "Backup")
+	if err != nil {
+		return err
+	}
+
+	logs, _, err := client.ArkBackupsApi.GetARKBackupLogs(context.Background(), orgID, clusterID, backupID)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to get backup logs", "clusterID", clusterID, "backupID", backupID)
+	}
+
+	fmt.Fprint(banzaiCli.Out(), logs)
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+func newScheduleCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule",
+		Aliases: []string{"schedules", "sch"},
+		Short:   "Manage scheduled backups",
+		Long:    "Manage schedules that create backups of the cluster periodically. The backup service must be enabled on the cluster.",
+	}
+
+	cmd.AddCommand(
+		newScheduleCreateCommand(banzaiCli),
+		newScheduleListCommand(banzaiCli),
+		newScheduleGetCommand(banzaiCli),
+		newScheduleDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// checkBackupServiceEnabled returns an error if the backup service can not be used on the cluster.
+func checkBackupServiceEnabled(client *pipeline.APIClient, orgID, clusterID int32) error {
+	enabled, err := isCommandEnabledForCluster(client, orgID, clusterID)
+	if err != nil {
+		return errors.WrapIf(err, "error during checking command availability")
+	}
+
+	if !enabled {
+		return NotAvailableError{}
+	}
+
+	response, _, err := client.ArkApi.CheckARKStatusGET(context.Background(), orgID, clusterID)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to check backup status", "clusterID", clusterID)
+	}
+
+	if !response.Enabled {
+		return errors.New("backup service is not enabled")
+	}
+
+	return nil
+}
+
+func getScheduleName(banzaiCli cli.Cli, client *pipeline.APIClient, orgID, clusterID int32, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return "", errors.New("NAME argument must be specified")
+	}
+
+	schedules, _, err := client.ArkSchedulesApi.ListARKSchedules(context.Background(), orgID, clusterID)
+	if err != nil {
+		return "", errors.WrapIfWithDetails(err, "failed to list schedules", "clusterID", clusterID)
+	}
+
+	if len(schedules) == 0 {
+		return "", errors.New("there are no backup schedules on the cluster")
+	}
+
+	scheduleOptions := make([]string, len(schedules))
+	for i, s := range schedules {
+		scheduleOptions[i] = s.Name
+	}
+
+	var selectedScheduleName string
+	err = input.DoQuestions([]input.QuestionMaker{
+		input.QuestionSelect{
+			QuestionInput: input.QuestionInput{
+				QuestionBase: input.QuestionBase{
+					Message: "Backup schedule",
+				},
+				Output: &selectedScheduleName,
+			},
+			Options: scheduleOptions,
+		},
+	})
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to select schedule")
+	}
+
+	return selectedScheduleName, nil
+}
+
+func writeSchedules(banzaiCli cli.Cli, schedules []pipeline.ScheduleResponse) error {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return output.Output(ctx, schedules)
+	}
+
+	type row struct {
+		Name               string
+		Schedule           string
+		TTL                string
+		IncludedNamespaces []string
+		ExcludedNamespaces []string
+		Status             string
+		LastBackup         string
+	}
+
+	table := make([]row, 0, len(schedules))
+	for _, s := range schedules {
+		table = append(table, row{
+			Name:               s.Name,
+			Schedule:           s.Schedule,
+			TTL:                s.Ttl,
+			IncludedNamespaces: s.Options.IncludedNamespaces,
+			ExcludedNamespaces: s.Options.ExcludedNamespaces,
+			Status:             s.Status,
+			LastBackup:         s.LastBackup,
+		})
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"Name", "Schedule", "TTL", "IncludedNamespaces", "ExcludedNamespaces", "Status", "LastBackup"},
+	}
+
+	return output.Output(ctx, table)
+}
@@ -0,0 +1,251 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+const scheduleCustomLabel = "custom"
+
+type scheduleCreateOptions struct {
+	clustercontext.Context
+
+	filePath                string
+	name                    string
+	schedule                string
+	ttl                     string
+	includedNamespaces      []string
+	excludedNamespaces      []string
+	includedResources       []string
+	excludedResources       []string
+	snapshotVolumes         bool
+	includeClusterResources bool
+}
+
+func newScheduleCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := scheduleCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"c", "new"},
+		Short:   "Create a backup schedule",
+		Long: "Create a schedule that backs up the cluster periodically.\n\n" +
+			"The schedule is a standard five-field cron expression (minute, hour, day of month, month, day of week) " +
+			"or one of the @yearly, @monthly, @weekly, @daily, @hourly and @every <duration> descriptors. " +
+			"The scope of the backups can be narrowed with namespace and resource selectors " +
+			"(label selectors are not supported by the Pipeline schedule API). " +
+			"The request is validated before it is sent to Pipeline.",
+		Example: `  banzai cluster service backup schedule create --name nightly --schedule "0 2 * * *" --ttl 168h --include-namespace default
+  banzai cluster service backup schedule create -f schedule.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runScheduleCreate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filePath, "file", "f", "", "Schedule creation descriptor file in JSON or YAML format")
+	flags.StringVar(&options.name, "name", "", "Name of the schedule")
+	flags.StringVar(&options.schedule, "schedule", "", "Cron expression of the schedule, for example \"0 12 * * *\"")
+	flags.StringVar(&options.ttl, "ttl", ttl1WeekValue, "Retention period of the created backups, for example 24h")
+	flags.StringSliceVar(&options.includedNamespaces, "include-namespace", nil, "Namespace to include in the backups (can be repeated, defaults to all namespaces)")
+	flags.StringSliceVar(&options.excludedNamespaces, "exclude-namespace", nil, "Namespace to exclude from the backups (can be repeated)")
+	flags.StringSliceVar(&options.includedResources, "include-resource", nil, "Resource type to include in the backups (can be repeated, defaults to all resources)")
+	flags.StringSliceVar(&options.excludedResources, "exclude-resource", nil, "Resource type to exclude from the backups (can be repeated)")
+	flags.BoolVar(&options.snapshotVolumes, "snapshot-volumes", true, "Take snapshots of persistent volumes")
+	flags.BoolVar(&options.includeClusterResources, "include-cluster-resources", true, "Include cluster-scoped resources in the backups")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "create")
+
+	return cmd
+}
+
+func runScheduleCreate(banzaiCli cli.Cli, options scheduleCreateOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	var request pipeline.CreateScheduleRequest
+	var err error
+	switch {
+	case options.filePath != "":
+		if request, err = readScheduleRequest(options.filePath); err != nil {
+			return err
+		}
+	case options.name == "" && banzaiCli.Interactive():
+		if request, err = buildScheduleRequestInteractively(options); err != nil {
+			return err
+		}
+	default:
+		request = buildScheduleRequest(options)
+	}
+
+	if err := validateScheduleRequest(request); err != nil {
+		return errors.WrapIf(err, "invalid schedule")
+	}
+
+	if err := checkBackupServiceEnabled(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	_, _, err = client.ArkSchedulesApi.CreateARKSchedule(context.Background(), orgID, clusterID, request)
+	if err != nil {
+		cli.LogAPIError("create backup schedule", err, request)
+		return errors.WrapIfWithDetails(err, "failed to create schedule", "clusterID", clusterID, "schedule", request.Name)
+	}
+
+	log.Infof("Backup schedule %q created", request.Name)
+
+	return nil
+}
+
+func readScheduleRequest(filePath string) (pipeline.CreateScheduleRequest, error) {
+	var request pipeline.CreateScheduleRequest
+
+	filename, raw, err := utils.ReadFileOrStdin(filePath)
+	if err != nil {
+		return request, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+	}
+
+	if err := utils.Unmarshal(raw, &request); err != nil {
+		return request, errors.WrapIfWithDetails(err, "failed to unmarshal input", "filename", filename)
+	}
+
+	return request, nil
+}
+
+func buildScheduleRequest(options scheduleCreateOptions) pipeline.CreateScheduleRequest {
+	return pipeline.CreateScheduleRequest{
+		Name:     options.name,
+		Schedule: strings.TrimSpace(options.schedule),
+		Ttl:      options.ttl,
+		Options: pipeline.BackupOptions{
+			IncludedNamespaces:      options.includedNamespaces,
+			ExcludedNamespaces:      options.excludedNamespaces,
+			IncludedResources:       options.includedResources,
+			ExcludedResources:       options.excludedResources,
+			SnapshotVolumes:         options.snapshotVolumes,
+			IncludeClusterResources: options.includeClusterResources,
+		},
+	}
+}
+
+func buildScheduleRequestInteractively(options scheduleCreateOptions) (pipeline.CreateScheduleRequest, error) {
+	var scheduleLabel string
+	var ttlLabel string
+	var namespaces string
+
+	err := input.DoQuestions([]input.QuestionMaker{
+		input.QuestionInput{
+			QuestionBase: input.QuestionBase{
+				Message: "Name of the schedule",
+			},
+			Output: &options.name,
+		},
+		input.QuestionSelect{
+			QuestionInput: input.QuestionInput{
+				QuestionBase: input.QuestionBase{
+					Message: "Schedule backups for every",
+					Help:    "Select a predefined schedule or enter a custom cron expression",
+				},
+				DefaultValue: scheduleDailyLabel,
+				Output:       &scheduleLabel,
+			},
+			Options: []string{scheduleDailyLabel, scheduleWeeklyLabel, scheduleMonthlyLabel, scheduleCustomLabel},
+		},
+		input.QuestionSelect{
+			QuestionInput: input.QuestionInput{
+				QuestionBase: input.QuestionBase{
+					Message: "Keep backups for",
+					Help:    "Retain backups for the specified period.",
+				},
+				DefaultValue: ttl1WeekLabel,
+				Output:       &ttlLabel,
+			},
+			Options: []string{ttl1DayLabel, ttl2DaysLabel, ttl1WeekLabel},
+		},
+		input.QuestionInput{
+			QuestionBase: input.QuestionBase{
+				Message: "Namespaces to back up",
+				Help:    "Comma separated list of namespaces, leave empty to back up all namespaces",
+			},
+			Output: &namespaces,
+		},
+	})
+	if err != nil {
+		return pipeline.CreateScheduleRequest{}, errors.WrapIf(err, "error during getting schedule options")
+	}
+
+	switch scheduleLabel {
+	case scheduleDailyLabel:
+		options.schedule = scheduleDailyValue
+	case scheduleWeeklyLabel:
+		options.schedule = scheduleWeeklyValue
+	case scheduleMonthlyLabel:
+		options.schedule = scheduleMonthlyValue
+	case scheduleCustomLabel:
+		err = input.DoQuestions([]input.QuestionMaker{
+			input.QuestionInput{
+				QuestionBase: input.QuestionBase{
+					Message: "Cron expression",
+					Help:    "Five-field cron expression (minute, hour, day of month, month, day of week)",
+				},
+				Output: &options.schedule,
+			},
+		})
+		if err != nil {
+			return pipeline.CreateScheduleRequest{}, errors.WrapIf(err, "error during getting cron expression")
+		}
+	default:
+		return pipeline.CreateScheduleRequest{}, errors.New("not supported schedule")
+	}
+
+	switch ttlLabel {
+	case ttl1DayLabel:
+		options.ttl = ttl1DayValue
+	case ttl2DaysLabel:
+		options.ttl = ttl2DaysValue
+	case ttl1WeekLabel:
+		options.ttl = ttl1WeekValue
+	}
+
+	options.includedNamespaces = nil
+	for _, ns := range strings.Split(namespaces, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			options.includedNamespaces = append(options.includedNamespaces, ns)
+		}
+	}
+
+	return buildScheduleRequest(options), nil
+}
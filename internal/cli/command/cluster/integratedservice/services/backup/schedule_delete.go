@@ -0,0 +1,89 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type scheduleDeleteOptions struct {
+	clustercontext.Context
+}
+
+func newScheduleDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := scheduleDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAME]",
+		Aliases: []string{"d", "remove", "rm"},
+		Short:   "Delete a backup schedule",
+		Long:    "Delete a backup schedule. The backups already created by the schedule are kept until they expire.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runScheduleDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete")
+
+	return cmd
+}
+
+func runScheduleDelete(banzaiCli cli.Cli, options scheduleDeleteOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	if err := checkBackupServiceEnabled(client, orgID, clusterID); err != nil {
+		return err
+	}
+
+	name, err := getScheduleName(banzaiCli, client, orgID, clusterID, args)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the backup schedule " + name + "?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	_, _, err = client.ArkSchedulesApi.DeleteARKSchedule(context.Background(), orgID, clusterID, name)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to delete schedule", "clusterID", clusterID, "schedule", name)
+	}
+
+	log.Infof("Backup schedule %q deleted", name)
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+// cronField describes the accepted values of a single cron expression field.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+var cronDescriptors = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// validateScheduleRequest checks a schedule creation request before it is sent to Pipeline.
+func validateScheduleRequest(request pipeline.CreateScheduleRequest) error {
+	if request.Name == "" {
+		return errors.New("name must be specified")
+	}
+
+	if msgs := validation.IsDNS1123Label(request.Name); len(msgs) > 0 {
+		return errors.NewWithDetails("invalid name: "+strings.Join(msgs, "; "), "name", request.Name)
+	}
+
+	if err := validateCronExpression(request.Schedule); err != nil {
+		return err
+	}
+
+	ttl, err := time.ParseDuration(request.Ttl)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "invalid TTL", "ttl", request.Ttl)
+	}
+
+	if ttl <= 0 {
+		return errors.NewWithDetails("TTL must be positive", "ttl", request.Ttl)
+	}
+
+	for _, namespaces := range [][]string{request.Options.IncludedNamespaces, request.Options.ExcludedNamespaces} {
+		for _, ns := range namespaces {
+			if ns == "*" {
+				continue
+			}
+
+			if msgs := validation.IsDNS1123Label(ns); len(msgs) > 0 {
+				return errors.NewWithDetails("invalid namespace: "+strings.Join(msgs, "; "), "namespace", ns)
+			}
+		}
+	}
+
+	for _, ns := range request.Options.IncludedNamespaces {
+		for _, excluded := range request.Options.ExcludedNamespaces {
+			if ns == excluded {
+				return errors.NewWithDetails("namespace is both included and excluded", "namespace", ns)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCronExpression accepts five-field cron expressions and the descriptors supported by Velero.
+func validateCronExpression(expr string) error {
+	if expr == "" {
+		return errors.New("schedule must be specified")
+	}
+
+	if strings.HasPrefix(expr, "@") {
+		if strings.HasPrefix(expr, "@every ") {
+			d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expr, "@every ")))
+			if err != nil || d <= 0 {
+				return errors.NewWithDetails("invalid @every duration", "schedule", expr)
+			}
+
+			return nil
+		}
+
+		for _, descriptor := range cronDescriptors {
+			if expr == descriptor {
+				return nil
+			}
+		}
+
+		return errors.NewWithDetails("unknown schedule descriptor", "schedule", expr)
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return errors.NewWithDetails("cron expression must have 5 fields", "schedule", expr)
+	}
+
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			return errors.WithDetails(err, "schedule", expr)
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) validate(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		rangePart := part
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangePart = part[:i]
+			step, err := strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return errors.Errorf("invalid step in %s field: %q", f.name, part)
+			}
+		}
+
+		if rangePart == "*" || rangePart == "?" {
+			continue
+		}
+
+		bounds := strings.SplitN(rangePart, "-", 2)
+		for _, bound := range bounds {
+			if _, err := f.parse(bound); err != nil {
+				return err
+			}
+		}
+
+		if len(bounds) == 2 {
+			from, _ := f.parse(bounds[0])
+			to, _ := f.parse(bounds[1])
+			if from > to {
+				return errors.Errorf("invalid range in %s field: %q", f.name, rangePart)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) parse(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, errors.Errorf("invalid value in %s field: %q (must be between %d and %d)", f.name, value, f.min, f.max)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,115 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestValidateCronExpression(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{expr: scheduleDailyValue, valid: true},
+		{expr: scheduleWeeklyValue, valid: true},
+		{expr: scheduleMonthlyValue, valid: true},
+		{expr: "*/15 0-6,22 * JAN-MAR mon-fri", valid: true},
+		{expr: "@daily", valid: true},
+		{expr: "@every 6h", valid: true},
+		{expr: "", valid: false},
+		{expr: "0 12 * *", valid: false},
+		{expr: "60 * * * *", valid: false},
+		{expr: "0 24 * * *", valid: false},
+		{expr: "0 0 0 * *", valid: false},
+		{expr: "0 0 * 13 *", valid: false},
+		{expr: "0 0 * * 7", valid: false},
+		{expr: "*/0 * * * *", valid: false},
+		{expr: "0 6-2 * * *", valid: false},
+		{expr: "@fortnightly", valid: false},
+		{expr: "@every forever", valid: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.expr, func(t *testing.T) {
+			err := validateCronExpression(test.expr)
+			if test.valid && err != nil {
+				t.Errorf("expected %q to be valid, got error: %v", test.expr, err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %q to be invalid", test.expr)
+			}
+		})
+	}
+}
+
+func TestValidateScheduleRequest(t *testing.T) {
+	valid := pipeline.CreateScheduleRequest{
+		Name:     "nightly",
+		Schedule: "0 2 * * *",
+		Ttl:      "168h",
+		Options: pipeline.BackupOptions{
+			IncludedNamespaces: []string{"default", "kube-system"},
+		},
+	}
+
+	tests := map[string]struct {
+		modify func(*pipeline.CreateScheduleRequest)
+		valid  bool
+	}{
+		"valid": {
+			modify: func(*pipeline.CreateScheduleRequest) {},
+			valid:  true,
+		},
+		"missing name": {
+			modify: func(r *pipeline.CreateScheduleRequest) { r.Name = "" },
+		},
+		"invalid name": {
+			modify: func(r *pipeline.CreateScheduleRequest) { r.Name = "Nightly_Backup" },
+		},
+		"invalid ttl": {
+			modify: func(r *pipeline.CreateScheduleRequest) { r.Ttl = "7 days" },
+		},
+		"negative ttl": {
+			modify: func(r *pipeline.CreateScheduleRequest) { r.Ttl = "-1h" },
+		},
+		"invalid namespace": {
+			modify: func(r *pipeline.CreateScheduleRequest) { r.Options.ExcludedNamespaces = []string{"Kube_System"} },
+		},
+		"included and excluded namespace": {
+			modify: func(r *pipeline.CreateScheduleRequest) { r.Options.ExcludedNamespaces = []string{"default"} },
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			request := valid
+			request.Options.IncludedNamespaces = append([]string(nil), valid.Options.IncludedNamespaces...)
+			test.modify(&request)
+
+			err := validateScheduleRequest(request)
+			if test.valid && err != nil {
+				t.Errorf("expected request to be valid, got error: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Error("expected request to be invalid")
+			}
+		})
+	}
+}
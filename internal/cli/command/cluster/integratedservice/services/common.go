@@ -42,7 +42,9 @@ type ServiceCommandManager interface {
 	specValidator
 }
 
-func NewServiceCommand(banzaiCLI cli.Cli, use string, scm ServiceCommandManager) *cobra.Command {
+// NewServiceCommand returns a cobra command for managing an integrated service.
+// Service specific subcommands are added next to the common ones.
+func NewServiceCommand(banzaiCLI cli.Cli, use string, scm ServiceCommandManager, subcommands ...*cobra.Command) *cobra.Command {
 	options := getOptions{}
 
 	cmd := &cobra.Command{
@@ -62,6 +64,7 @@ func NewServiceCommand(banzaiCLI cli.Cli, use string, scm ServiceCommandManager)
 		newDeactivateCommand(banzaiCLI, use, scm),
 		newUpdateCommand(banzaiCLI, use, scm),
 	)
+	cmd.AddCommand(subcommands...)
 
 	return cmd
 }
@@ -24,10 +24,12 @@ import (
 
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 )
 
 type deactivateOptions struct {
 	clustercontext.Context
+	wait *wait.Options
 }
 
 type deactivateManager interface {
@@ -49,6 +51,7 @@ func newDeactivateCommand(banzaiCli cli.Cli, use string, mngr deactivateManager)
 	}
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, fmt.Sprintf("deactivate %s cluster service of", mngr.ReadableName()))
+	options.wait = wait.NewOptions(cmd)
 
 	return cmd
 }
@@ -80,5 +83,9 @@ func runDeactivate(
 
 	log.Infof("service %q started to deactivate", m.ReadableName())
 
-	return nil
+	if !options.wait.Enabled() {
+		return nil
+	}
+
+	return waitForService(banzaiCLI, orgId, clusterId, m, "deactivation", "INACTIVE", options.wait)
 }
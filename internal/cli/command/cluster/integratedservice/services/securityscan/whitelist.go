@@ -0,0 +1,116 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securityscan
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewWhitelistCommand returns a cobra command for managing the release whitelist of the security scan.
+func NewWhitelistCommand(banzaiCLI cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "whitelist",
+		Aliases: []string{"whitelists", "wl"},
+		Short:   "Manage the release whitelist of the security scan",
+		Long:    "Manage the releases that are deployed even if the images they use fail the security scan policy checks.",
+	}
+
+	cmd.AddCommand(
+		newWhitelistAddCommand(banzaiCLI),
+		newWhitelistListCommand(banzaiCLI),
+		newWhitelistDeleteCommand(banzaiCLI),
+	)
+
+	return cmd
+}
+
+// readWhitelistItems reads a single whitelist item or a list of whitelist items in JSON or YAML format from a file or from the standard input.
+func readWhitelistItems(filePath string) ([]pipeline.ReleaseWhiteListItem, error) {
+	filename, raw, err := utils.ReadFileOrStdin(filePath)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+	}
+
+	var items []pipeline.ReleaseWhiteListItem
+	if err := utils.Unmarshal(raw, &items); err != nil {
+		var item pipeline.ReleaseWhiteListItem
+		if err := utils.Unmarshal(raw, &item); err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to unmarshal whitelist items", "filename", filename)
+		}
+
+		items = []pipeline.ReleaseWhiteListItem{item}
+	}
+
+	if len(items) == 0 {
+		return nil, errors.NewWithDetails("no whitelist items found", "filename", filename)
+	}
+
+	return items, nil
+}
+
+func validateWhitelistItem(item pipeline.ReleaseWhiteListItem) error {
+	if item.Name == "" {
+		return errors.New("release name must be specified")
+	}
+
+	if item.Owner == "" {
+		return errors.NewWithDetails("owner must be specified", "release", item.Name)
+	}
+
+	if item.Reason == "" {
+		return errors.NewWithDetails("reason must be specified", "release", item.Name)
+	}
+
+	return nil
+}
+
+func getWhitelistItemName(banzaiCLI cli.Cli, orgID, clusterID int32, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+
+	if !banzaiCLI.Interactive() {
+		return "", errors.New("RELEASE argument must be specified")
+	}
+
+	items, _, err := banzaiCLI.Client().WhitelistApi.ListWhitelists(context.Background(), orgID, clusterID)
+	if err != nil {
+		return "", errors.WrapIfWithDetails(err, "failed to list whitelist", "clusterID", clusterID)
+	}
+
+	if len(items) == 0 {
+		return "", errors.New("there are no whitelisted releases on the cluster")
+	}
+
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.Name
+	}
+
+	var name string
+	if err := survey.AskOne(&survey.Select{Message: "Whitelisted release:", Options: names}, &name); err != nil {
+		return "", errors.WrapIf(err, "failed to select whitelisted release")
+	}
+
+	return name, nil
+}
@@ -0,0 +1,153 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securityscan
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type whitelistAddOptions struct {
+	clustercontext.Context
+
+	filePath string
+	owner    string
+	reason   string
+}
+
+func newWhitelistAddCommand(banzaiCLI cli.Cli) *cobra.Command {
+	options := whitelistAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add [RELEASE]",
+		Aliases: []string{"a", "create"},
+		Short:   "Add releases to the whitelist",
+		Long: "Add a release to the whitelist of the security scan, or add several releases at once from a file.\n\n" +
+			"The file (or the standard input with `-f -`) contains a single item or a list of items with name, owner and reason fields in JSON or YAML format.",
+		Example: `  banzai cluster service securityscan whitelist add my-release --reason "known false positive"
+  banzai cluster service securityscan whitelist add -f whitelist.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runWhitelistAdd(banzaiCLI, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filePath, "file", "f", "", "Whitelist items file in JSON or YAML format")
+	flags.StringVar(&options.owner, "owner", "", "Owner of the whitelist item (default: the current user)")
+	flags.StringVar(&options.reason, "reason", "", "Reason of whitelisting the release")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCLI, "whitelist releases of")
+
+	return cmd
+}
+
+func runWhitelistAdd(banzaiCLI cli.Cli, options whitelistAddOptions, args []string) error {
+	orgID := banzaiCLI.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	var items []pipeline.ReleaseWhiteListItem
+	if options.filePath != "" {
+		if len(args) > 0 {
+			return errors.New("RELEASE argument and --file flag are mutually exclusive")
+		}
+
+		var err error
+		if items, err = readWhitelistItems(options.filePath); err != nil {
+			return err
+		}
+	} else {
+		item := pipeline.ReleaseWhiteListItem{
+			Owner:  options.owner,
+			Reason: options.reason,
+		}
+		if len(args) > 0 {
+			item.Name = args[0]
+		}
+
+		if banzaiCLI.Interactive() {
+			if err := askWhitelistItem(&item); err != nil {
+				return err
+			}
+		}
+
+		items = []pipeline.ReleaseWhiteListItem{item}
+	}
+
+	owner := options.owner
+	for i := range items {
+		if items[i].Owner != "" {
+			continue
+		}
+
+		if owner == "" {
+			user, _, err := banzaiCLI.Client().UsersApi.GetCurrentUser(context.Background())
+			if err != nil {
+				return errors.WrapIf(err, "failed to get current user, specify the owner with --owner")
+			}
+			owner = user.Login
+		}
+
+		items[i].Owner = owner
+	}
+
+	for _, item := range items {
+		if err := validateWhitelistItem(item); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		if _, err := banzaiCLI.Client().WhitelistApi.CreateWhitelists(context.Background(), orgID, clusterID, item); err != nil {
+			cli.LogAPIError("add release to whitelist", err, item)
+			return errors.WrapIfWithDetails(err, "failed to add release to whitelist", "clusterID", clusterID, "release", item.Name)
+		}
+
+		log.Infof("Release %q added to the whitelist", item.Name)
+	}
+
+	return nil
+}
+
+func askWhitelistItem(item *pipeline.ReleaseWhiteListItem) error {
+	if item.Name == "" {
+		if err := survey.AskOne(&survey.Input{Message: "Release name:"}, &item.Name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to read the name of the release")
+		}
+	}
+
+	if item.Reason == "" {
+		if err := survey.AskOne(&survey.Input{Message: "Reason of whitelisting the release:"}, &item.Reason, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to read the reason of whitelisting")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securityscan
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type whitelistDeleteOptions struct {
+	clustercontext.Context
+}
+
+func newWhitelistDeleteCommand(banzaiCLI cli.Cli) *cobra.Command {
+	options := whitelistDeleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [RELEASE]",
+		Aliases: []string{"d", "remove", "rm"},
+		Short:   "Remove a release from the whitelist",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runWhitelistDelete(banzaiCLI, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCLI, "remove whitelisted release of")
+
+	return cmd
+}
+
+func runWhitelistDelete(banzaiCLI cli.Cli, options whitelistDeleteOptions, args []string) error {
+	orgID := banzaiCLI.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	name, err := getWhitelistItemName(banzaiCLI, orgID, clusterID, args)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCLI.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to remove the release " + name + " from the whitelist?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, err := banzaiCLI.Client().WhitelistApi.DeleteWhitelist(context.Background(), orgID, clusterID, name); err != nil {
+		cli.LogAPIError("remove release from whitelist", err, name)
+		return errors.WrapIfWithDetails(err, "failed to remove release from whitelist", "clusterID", clusterID, "release", name)
+	}
+
+	log.Infof("Release %q removed from the whitelist", name)
+
+	return nil
+}
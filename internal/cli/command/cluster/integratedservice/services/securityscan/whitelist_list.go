@@ -0,0 +1,70 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securityscan
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type whitelistListOptions struct {
+	clustercontext.Context
+}
+
+func newWhitelistListCommand(banzaiCLI cli.Cli) *cobra.Command {
+	options := whitelistListOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List whitelisted releases",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runWhitelistList(banzaiCLI, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCLI, "list whitelisted releases of")
+
+	return cmd
+}
+
+func runWhitelistList(banzaiCLI cli.Cli, options whitelistListOptions) error {
+	orgID := banzaiCLI.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	items, _, err := banzaiCLI.Client().WhitelistApi.ListWhitelists(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list whitelist", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to list whitelist", "clusterID", clusterID)
+	}
+
+	format.WhitelistsWrite(banzaiCLI, items)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
+)
+
+type serviceNamer interface {
+	ReadableName() string
+	ServiceName() string
+}
+
+// waitForService waits until the service of a cluster reaches the given status.
+func waitForService(banzaiCli cli.Cli, orgID int32, clusterID int32, m serviceNamer, operation string, status string, waitOptions *wait.Options) error {
+	description := fmt.Sprintf("%s service %s", m.ReadableName(), operation)
+
+	return waitOptions.Until(description, func(ctx context.Context) (bool, error) {
+		details, _, err := banzaiCli.Client().IntegratedServicesApi.IntegratedServiceDetails(ctx, orgID, clusterID, m.ServiceName())
+		if err != nil {
+			cli.LogAPIError(fmt.Sprintf("get %s cluster service", m.ReadableName()), err, clusterID)
+			return false, errors.WrapIf(err, "failed to get service details")
+		}
+
+		switch details.Status {
+		case status:
+			return true, nil
+		case "ERROR":
+			return false, wait.Failed("%s failed", description)
+		default:
+			return false, nil
+		}
+	})
+}
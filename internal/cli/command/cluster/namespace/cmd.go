@@ -0,0 +1,52 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewNamespaceCommand returns a cobra command for `namespace` subcommands.
+func NewNamespaceCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "namespace",
+		Aliases: []string{"namespaces", "ns"},
+		Short:   "Manage Kubernetes namespaces of the cluster",
+	}
+
+	cmd.AddCommand(
+		newListCommand(banzaiCli),
+		newDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// listNamespaces returns the namespaces of the cluster.
+func listNamespaces(banzaiCli cli.Cli, orgID, clusterID int32) ([]pipeline.NamespaceItem, error) {
+	response, _, err := banzaiCli.Client().ClustersApi.ListNamespaces(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list namespaces", err, clusterID)
+		return nil, errors.WrapIfWithDetails(err, "failed to list namespaces", "clusterID", clusterID)
+	}
+
+	return response.Namespaces, nil
+}
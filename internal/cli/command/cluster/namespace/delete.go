@@ -0,0 +1,124 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+func newDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete NAMESPACE...",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete namespaces of the cluster",
+		Long:    "Delete namespaces of the cluster with all the resources in them. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete namespaces of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	namespaces, err := listNamespaces(banzaiCli, orgID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		existing[namespace.Name] = true
+	}
+
+	toDelete := make([]pipeline.NamespaceItem, 0, len(args))
+	for _, name := range args {
+		if !existing[name] {
+			return errors.Errorf("namespace %q not found", name)
+		}
+
+		toDelete = append(toDelete, pipeline.NamespaceItem{Name: name})
+	}
+
+	if banzaiCli.Interactive() {
+		format.NamespacesWrite(banzaiCli, toDelete)
+
+		confirmed := false
+		message := fmt.Sprintf("Do you want to DELETE the namespace%s with all the resources in %s?", plural(len(args), "", "s"), plural(len(args), "it", "them"))
+		_ = survey.AskOne(&survey.Confirm{Message: message}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	var failed []string
+	for _, namespace := range toDelete {
+		_, err := client.ClustersApi.DeleteNamespace(context.Background(), orgID, clusterID, namespace.Name)
+		if err != nil {
+			cli.LogAPIError("delete namespace", err, namespace.Name)
+			log.Errorf("failed to delete namespace %q: %v", namespace.Name, err)
+			failed = append(failed, namespace.Name)
+			continue
+		}
+
+		log.Infof("namespace %q deleted", namespace.Name)
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("failed to delete namespace%s: %s", plural(len(failed), "", "s"), strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func plural(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+
+	return plural
+}
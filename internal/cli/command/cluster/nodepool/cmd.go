@@ -33,6 +33,7 @@ func NewNodePoolCommand(banzaiCli cli.Cli) *cobra.Command {
 		NewDeleteCommand(banzaiCli),
 		NewListCommand(banzaiCli),
 		NewUpdateCommand(banzaiCli),
+		NewLabelCommand(banzaiCli),
 	)
 
 	return cmd
@@ -22,6 +22,7 @@ import (
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -32,6 +33,8 @@ type createOptions struct {
 	file string
 
 	name string
+
+	wait *wait.Options
 }
 
 func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
@@ -55,6 +58,8 @@ func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags.StringVarP(&options.file, "file", "f", "", "Node pool descriptor file")
 	flags.StringVarP(&options.name, "name", "n", "", "Node pool name")
 
+	options.wait = wait.NewOptions(cmd)
+
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "create")
 
 	return cmd
@@ -119,5 +124,19 @@ func createNodePool(banzaiCli cli.Cli, options createOptions) error {
 		return err
 	}
 
-	return nil
+	if !options.wait.Enabled() {
+		return nil
+	}
+
+	names := make([]string, 0, len(request.NodePools)+1)
+	if request.Name != "" {
+		names = append(names, request.Name)
+	}
+	for name := range request.NodePools {
+		names = append(names, name)
+	}
+
+	return waitForNodePools(banzaiCli, orgID, clusterID, "node pool creation", names, func(status string, found bool) bool {
+		return found && status != "" && status != "CREATING"
+	}, options.wait)
 }
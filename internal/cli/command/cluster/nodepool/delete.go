@@ -22,12 +22,15 @@ import (
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 type deleteOptions struct {
 	clustercontext.Context
+
+	wait *wait.Options
 }
 
 func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
@@ -47,6 +50,7 @@ func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
 	}
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete")
+	options.wait = wait.NewOptions(cmd)
 
 	return cmd
 }
@@ -89,7 +93,14 @@ func deleteNodePool(banzaiCli cli.Cli, options deleteOptions, args []string) err
 		_, _ = fmt.Fprintf(banzaiCli.Out(), "delete process initiated for node pool '%s'\n", nodePoolName)
 	case http.StatusNoContent:
 		_, _ = fmt.Fprintf(banzaiCli.Out(), "node pool '%s' does not exist, nothing to do\n", nodePoolName)
+		return nil
+	}
+
+	if !options.wait.Enabled() {
+		return nil
 	}
 
-	return nil
+	return waitForNodePools(banzaiCli, orgID, clusterID, "node pool deletion", []string{nodePoolName}, func(_ string, found bool) bool {
+		return !found
+	}, options.wait)
 }
@@ -0,0 +1,128 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/pkg/process"
+)
+
+// NewLabelCommand returns a cobra command for `nodepool label` subcommands.
+func NewLabelCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "label",
+		Aliases: []string{"labels"},
+		Short:   "Manage node pool labels",
+	}
+
+	cmd.AddCommand(
+		newLabelListCommand(banzaiCli),
+		newLabelSetCommand(banzaiCli),
+		newLabelRemoveCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// reservedLabels returns the names of the labels of a node pool which are managed by Pipeline.
+func reservedLabels(banzaiCli cli.Cli, orgID int32, clusterID int32, nodePoolName string) (map[string]bool, error) {
+	labels, _, err := banzaiCli.Client().ClustersApi.ListNodepoolLabels(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list node pool labels", err, clusterID)
+		return nil, errors.WrapIf(err, "failed to list node pool labels")
+	}
+
+	reserved := make(map[string]bool)
+	for _, label := range labels[nodePoolName] {
+		if label.Reserved {
+			reserved[label.Name] = true
+		}
+	}
+
+	return reserved, nil
+}
+
+// updateNodePoolLabels updates the labels of a node pool, keeping its other settings, and tails the update process.
+// change receives the current non-reserved labels of the node pool and returns whether it changed them.
+func updateNodePoolLabels(banzaiCli cli.Cli, orgID int32, clusterID int32, nodePoolName string, reserved map[string]bool, change func(labels map[string]string) (bool, error)) error {
+	client := banzaiCli.Client()
+
+	nodePools, _, err := client.ClustersApi.ListNodePools(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list node pools", err, clusterID)
+		return errors.WrapIf(err, "failed to list node pools")
+	}
+
+	var nodePool *pipeline.NodePoolSummary
+	for i := range nodePools {
+		if nodePools[i].Name == nodePoolName {
+			nodePool = &nodePools[i]
+			break
+		}
+	}
+
+	if nodePool == nil {
+		return errors.Errorf("node pool %q not found", nodePoolName)
+	}
+
+	request, changed, err := labelUpdateRequest(*nodePool, reserved, change)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		log.Infof("labels of node pool %q are up to date", nodePoolName)
+		return nil
+	}
+
+	log.Debugf("update request: %#v", request)
+
+	response, _, err := client.ClustersApi.UpdateNodePool(context.Background(), orgID, clusterID, nodePoolName, request)
+	if err != nil {
+		cli.LogAPIError("update node pool", err, request)
+		return errors.WrapIf(err, "failed to update node pool")
+	}
+
+	return process.TailProcess(banzaiCli, response.ProcessId)
+}
+
+// labelUpdateRequest returns the request updating the labels of a node pool with change.
+// The labels reserved by Pipeline are not sent back, Pipeline sets them itself.
+func labelUpdateRequest(nodePool pipeline.NodePoolSummary, reserved map[string]bool, change func(labels map[string]string) (bool, error)) (pipeline.UpdateNodePoolRequest, bool, error) {
+	labels := make(map[string]string, len(nodePool.Labels))
+	for name, value := range nodePool.Labels {
+		if !reserved[name] {
+			labels[name] = value
+		}
+	}
+
+	changed, err := change(labels)
+	if err != nil || !changed {
+		return pipeline.UpdateNodePoolRequest{}, false, err
+	}
+
+	return pipeline.UpdateNodePoolRequest{
+		Size:        nodePool.Size,
+		Labels:      labels,
+		Autoscaling: nodePool.Autoscaling,
+	}, true, nil
+}
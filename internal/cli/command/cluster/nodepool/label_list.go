@@ -0,0 +1,118 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type labelListOptions struct {
+	clustercontext.Context
+
+	reserved bool
+}
+
+type labelListItem struct {
+	NodePool string
+	Name     string
+	Value    string
+	Reserved bool
+}
+
+func newLabelListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := labelListOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list [NODE_POOL]",
+		Aliases: []string{"l", "ls"},
+		Short:   "List node pool labels",
+		Long:    "List the labels of the node pools of a cluster, or the labels of a single node pool.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runLabelList(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list node pool labels of")
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.reserved, "reserved", false, "List the labels managed by Pipeline too")
+
+	return cmd
+}
+
+func runLabelList(banzaiCli cli.Cli, options labelListOptions, args []string) error {
+	if err := options.Init(); err != nil {
+		return errors.WrapIf(err, "failed to initialize options")
+	}
+
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	labels, _, err := banzaiCli.Client().ClustersApi.ListNodepoolLabels(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list node pool labels", err, clusterID)
+		return errors.WrapIf(err, "failed to list node pool labels")
+	}
+
+	if len(args) > 0 {
+		if _, ok := labels[args[0]]; !ok {
+			return errors.Errorf("node pool %q not found", args[0])
+		}
+	}
+
+	items := make([]labelListItem, 0)
+	for nodePool, nodePoolLabels := range labels {
+		if len(args) > 0 && nodePool != args[0] {
+			continue
+		}
+
+		for _, label := range nodePoolLabels {
+			if label.Reserved && !options.reserved {
+				continue
+			}
+
+			items = append(items, labelListItem{
+				NodePool: nodePool,
+				Name:     label.Name,
+				Value:    label.Value,
+				Reserved: label.Reserved,
+			})
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].NodePool != items[j].NodePool {
+			return items[i].NodePool < items[j].NodePool
+		}
+
+		return items[i].Name < items[j].Name
+	})
+
+	format.NodePoolLabelsWrite(banzaiCli, items)
+
+	return nil
+}
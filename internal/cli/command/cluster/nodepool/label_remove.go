@@ -0,0 +1,90 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type labelRemoveOptions struct {
+	clustercontext.Context
+}
+
+func newLabelRemoveCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := labelRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "remove NODE_POOL NAME...",
+		Aliases: []string{"rm", "delete", "del"},
+		Short:   "Remove node pool labels",
+		Long: "Remove labels from a node pool. The other labels and settings of the node pool are kept.\n\n" +
+			"The node pool is updated and the command follows the update process.",
+		Example: `  banzai cluster nodepool label remove pool1 tier`,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runLabelRemove(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "remove node pool labels of")
+
+	return cmd
+}
+
+func runLabelRemove(banzaiCli cli.Cli, options labelRemoveOptions, args []string) error {
+	if err := options.Init(); err != nil {
+		return errors.WrapIf(err, "failed to initialize options")
+	}
+
+	nodePoolName := args[0]
+	names := args[1:]
+
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	reserved, err := reservedLabels(banzaiCli, orgID, clusterID, nodePoolName)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if reserved[name] {
+			return errors.Errorf("label %q is managed by Pipeline", name)
+		}
+	}
+
+	return updateNodePoolLabels(banzaiCli, orgID, clusterID, nodePoolName, reserved, func(labels map[string]string) (bool, error) {
+		changed := false
+		for _, name := range names {
+			if _, ok := labels[name]; !ok {
+				log.Warnf("node pool %q has no label %q", nodePoolName, name)
+				continue
+			}
+
+			delete(labels, name)
+			changed = true
+		}
+
+		return changed, nil
+	})
+}
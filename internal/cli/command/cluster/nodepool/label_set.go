@@ -0,0 +1,117 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type labelSetOptions struct {
+	clustercontext.Context
+}
+
+func newLabelSetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := labelSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "set NODE_POOL NAME=VALUE...",
+		Aliases: []string{"add"},
+		Short:   "Set node pool labels",
+		Long: "Add or change labels of a node pool. The other labels and settings of the node pool are kept.\n\n" +
+			"The node pool is updated and the command follows the update process.",
+		Example: `  banzai cluster nodepool label set pool1 team=scheduling tier=batch`,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runLabelSet(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "set node pool labels of")
+
+	return cmd
+}
+
+func runLabelSet(banzaiCli cli.Cli, options labelSetOptions, args []string) error {
+	if err := options.Init(); err != nil {
+		return errors.WrapIf(err, "failed to initialize options")
+	}
+
+	nodePoolName := args[0]
+
+	newLabels, err := parseLabels(args[1:])
+	if err != nil {
+		return err
+	}
+
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	reserved, err := reservedLabels(banzaiCli, orgID, clusterID, nodePoolName)
+	if err != nil {
+		return err
+	}
+
+	for name := range newLabels {
+		if reserved[name] {
+			return errors.Errorf("label %q is managed by Pipeline", name)
+		}
+	}
+
+	return updateNodePoolLabels(banzaiCli, orgID, clusterID, nodePoolName, reserved, func(labels map[string]string) (bool, error) {
+		changed := false
+		for name, value := range newLabels {
+			if current, ok := labels[name]; !ok || current != value {
+				labels[name] = value
+				changed = true
+			}
+		}
+
+		return changed, nil
+	})
+}
+
+// parseLabels parses and validates NAME=VALUE arguments.
+func parseLabels(args []string) (map[string]string, error) {
+	labels := make(map[string]string, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid label %q, must be in NAME=VALUE format", arg)
+		}
+
+		name, value := parts[0], parts[1]
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return nil, errors.Errorf("invalid label name %q: %s", name, strings.Join(errs, "; "))
+		}
+
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, errors.Errorf("invalid value of label %q: %s", name, strings.Join(errs, "; "))
+		}
+
+		labels[name] = value
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "simple",
+			args: []string{"team=scheduling", "example.com/tier=batch"},
+			want: map[string]string{"team": "scheduling", "example.com/tier": "batch"},
+		},
+		{
+			name: "empty value",
+			args: []string{"team="},
+			want: map[string]string{"team": ""},
+		},
+		{
+			name:    "missing value",
+			args:    []string{"team"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid name",
+			args:    []string{"-team=scheduling"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			args:    []string{"team=a b"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseLabels(test.args)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parseLabels() error = %v, wantErr %v", err, test.wantErr)
+			}
+
+			if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseLabels() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLabelUpdateRequest(t *testing.T) {
+	nodePool := pipeline.NodePoolSummary{
+		Name: "pool1",
+		Size: 3,
+		Labels: map[string]string{
+			"nodepool.banzaicloud.io/name": "pool1",
+			"team":                         "scheduling",
+		},
+	}
+	reserved := map[string]bool{"nodepool.banzaicloud.io/name": true}
+
+	request, changed, err := labelUpdateRequest(nodePool, reserved, func(labels map[string]string) (bool, error) {
+		if _, ok := labels["nodepool.banzaicloud.io/name"]; ok {
+			t.Error("reserved labels must not be passed to change")
+		}
+
+		labels["tier"] = "batch"
+
+		return true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !changed {
+		t.Fatal("expected the labels to be changed")
+	}
+
+	want := map[string]string{"team": "scheduling", "tier": "batch"}
+	if !reflect.DeepEqual(request.Labels, want) {
+		t.Errorf("request labels = %v, want %v", request.Labels, want)
+	}
+
+	if request.Size != nodePool.Size {
+		t.Errorf("request size = %d, want %d", request.Size, nodePool.Size)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/apply"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/nodepool/update"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
@@ -31,7 +32,8 @@ import (
 type updateOptions struct {
 	clustercontext.Context
 
-	file string
+	file   string
+	dryRun bool
 }
 
 func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
@@ -53,6 +55,7 @@ func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags := cmd.Flags()
 
 	flags.StringVarP(&options.file, "file", "f", "", "Node pool descriptor file")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Show how the descriptor differs from the live state of the node pool without updating it")
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "update")
 
@@ -95,6 +98,10 @@ func updateNodePool(banzaiCli cli.Cli, options updateOptions, args []string) err
 
 	log.Debugf("update request: %#v", request)
 
+	if options.dryRun {
+		return diffNodePool(banzaiCli, options, nodePoolName, request)
+	}
+
 	response, resp, err := client.ClustersApi.UpdateNodePool(context.Background(), orgID, clusterID, nodePoolName, request)
 	if err != nil {
 		cli.LogAPIError("update node pool", err, request)
@@ -113,3 +120,27 @@ func updateNodePool(banzaiCli cli.Cli, options updateOptions, args []string) err
 
 	return process.TailProcess(banzaiCli, response.ProcessId)
 }
+
+func diffNodePool(banzaiCli cli.Cli, options updateOptions, nodePoolName string, request pipeline.UpdateNodePoolRequest) error {
+	nodePools, _, err := banzaiCli.Client().ClustersApi.ListNodePools(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID())
+	if err != nil {
+		cli.LogAPIError("list node pools", err, options.ClusterID())
+		return errors.WrapIf(err, "failed to list node pools")
+	}
+
+	for _, nodePool := range nodePools {
+		if nodePool.Name != nodePoolName {
+			continue
+		}
+
+		nodePool.Status = ""
+		nodePool.StatusMessage = ""
+
+		// the rollout options are not part of the node pool state
+		request.Options = pipeline.BaseUpdateNodePoolOptions{}
+
+		return apply.WriteDescriptorDiff(banzaiCli, apply.KindNodePool, nodePoolName, options.ClusterName(), request, nodePool)
+	}
+
+	return errors.Errorf("node pool %q not found", nodePoolName)
+}
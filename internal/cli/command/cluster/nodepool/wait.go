@@ -0,0 +1,66 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodepool
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
+)
+
+// waitForNodePools waits until done returns true for the status of every named node pool.
+// done is called with found set to false for node pools which do not exist.
+func waitForNodePools(
+	banzaiCli cli.Cli,
+	orgID int32,
+	clusterID int32,
+	description string,
+	names []string,
+	done func(status string, found bool) bool,
+	waitOptions *wait.Options,
+) error {
+	return waitOptions.Until(description, func(ctx context.Context) (bool, error) {
+		nodePools, _, err := banzaiCli.Client().ClustersApi.ListNodePools(ctx, orgID, clusterID)
+		if err != nil {
+			cli.LogAPIError("list node pools", err, clusterID)
+			return false, errors.WrapIf(err, "failed to list node pools")
+		}
+
+		for _, name := range names {
+			status, found := "", false
+			for _, np := range nodePools {
+				if np.Name != name {
+					continue
+				}
+
+				if np.Status == "ERROR" {
+					return false, wait.Failed("%s failed for node pool %s: %s", description, name, np.StatusMessage)
+				}
+
+				status, found = np.Status, true
+				break
+			}
+
+			if !done(status, found) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+}
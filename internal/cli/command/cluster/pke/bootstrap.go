@@ -0,0 +1,75 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type bootstrapOptions struct {
+	clustercontext.Context
+}
+
+func newBootstrapCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := bootstrapOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: "Show the bootstrap information of the cluster",
+		Long:  "Show the master address, the join token and the discovery token CA certificate hash needed to join a node to the PKE cluster.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runBootstrap(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "show bootstrap information of")
+
+	return cmd
+}
+
+func runBootstrap(banzaiCli cli.Cli, options bootstrapOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	bootstrap, _, err := banzaiCli.Client().ClustersApi.GetClusterBootstrap(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster bootstrap", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to get cluster bootstrap information", "clusterID", clusterID)
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"MasterAddress", "Token", "DiscoveryTokenCaCertHash"},
+	}
+
+	return output.SingleOutput(ctx, bootstrap)
+}
@@ -0,0 +1,171 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type commandsOptions struct {
+	clustercontext.Context
+
+	nodePool string
+}
+
+type commandRow struct {
+	NodePool string `json:"nodePool"`
+	Command  string `json:"command"`
+}
+
+func newCommandsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := commandsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "commands",
+		Aliases: []string{"command", "cmd"},
+		Short:   "Show the node install commands of the node pools",
+		Long: "Show the commands which install and join a node to a node pool of the PKE cluster.\n\n" +
+			"With --node-pool only the command is written to the output, so it can be passed to a remote shell directly.",
+		Example: `  banzai cluster pke commands --cluster-name my-cluster --node-pool pool1 | ssh root@10.0.0.12 sh`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runCommands(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.nodePool, "node-pool", "", "Show only the install command of the given node pool")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "show PKE install commands of")
+
+	return cmd
+}
+
+func runCommands(banzaiCli cli.Cli, options commandsOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	commands, err := getPKECommands(banzaiCli, orgID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if options.nodePool != "" {
+		command, ok := commands[options.nodePool]
+		if !ok {
+			return errors.Errorf("node pool %q not found", options.nodePool)
+		}
+
+		_, err := fmt.Fprintln(banzaiCli.Out(), command)
+
+		return errors.WrapIf(err, "failed to write command")
+	}
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		return writeCommands(banzaiCli, commands)
+	}
+
+	rows := make([]commandRow, 0, len(commands))
+	for _, nodePool := range sortedKeys(commands) {
+		rows = append(rows, commandRow{NodePool: nodePool, Command: commands[nodePool]})
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"NodePool", "Command"},
+	}
+
+	return output.Output(ctx, rows)
+}
+
+// writeCommands writes the install commands as a shell script, as they are too long to fit in a table.
+func writeCommands(banzaiCli cli.Cli, commands map[string]string) error {
+	for i, nodePool := range sortedKeys(commands) {
+		if i > 0 {
+			fmt.Fprintln(banzaiCli.Out())
+		}
+
+		if _, err := fmt.Fprintf(banzaiCli.Out(), "# %s\n%s\n", nodePool, commands[nodePool]); err != nil {
+			return errors.WrapIf(err, "failed to write commands")
+		}
+	}
+
+	return nil
+}
+
+// getPKECommands returns the install commands of the node pools of a PKE cluster.
+//
+// The generated client can not be used, because its response model does not describe
+// the node pool name to command map returned by Pipeline.
+func getPKECommands(banzaiCli cli.Cli, orgID, clusterID int32) (map[string]string, error) {
+	config := banzaiCli.Client().GetConfig()
+
+	url := fmt.Sprintf("%s/api/v1/orgs/%d/clusters/%d/pke/commands", config.BasePath, orgID, clusterID)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create request")
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", config.UserAgent)
+
+	resp, err := config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to get PKE commands")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.NewWithDetails("getting PKE commands failed with http status code", "status_code", resp.StatusCode)
+	}
+
+	commands := make(map[string]string)
+	if err := json.NewDecoder(resp.Body).Decode(&commands); err != nil {
+		return nil, errors.WrapIf(err, "failed to decode PKE commands")
+	}
+
+	return commands, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,113 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"context"
+	"sort"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type nodesOptions struct {
+	clustercontext.Context
+}
+
+type nodeRow struct {
+	NodePool string `json:"nodePool"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+}
+
+func newNodesCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := nodesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "nodes",
+		Aliases: []string{"node"},
+		Short:   "Show the readiness of the nodes of the cluster",
+		Long: "Show whether the master node of the PKE cluster has reported to be ready, " +
+			"and the status of the nodes which have joined the node pools of the cluster.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runNodes(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "show node readiness of")
+
+	return cmd
+}
+
+func runNodes(banzaiCli cli.Cli, options nodesOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	readiness, _, err := client.ClustersApi.GetReadyPKENode(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get PKE node readiness", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to get node readiness", "clusterID", clusterID)
+	}
+
+	if readiness.Master.Ready {
+		log.Info("master node has reported to be ready")
+	} else {
+		log.Warn("master node has not reported to be ready yet")
+	}
+
+	cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to get cluster", "clusterID", clusterID)
+	}
+
+	rows := make([]nodeRow, 0)
+	for nodePool, details := range cluster.NodePools {
+		for name, summary := range details.ResourceSummary {
+			rows = append(rows, nodeRow{NodePool: nodePool, Name: name, Status: summary.Status})
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].NodePool != rows[j].NodePool {
+			return rows[i].NodePool < rows[j].NodePool
+		}
+
+		return rows[i].Name < rows[j].Name
+	})
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"NodePool", "Name", "Status"},
+	}
+
+	return output.Output(ctx, rows)
+}
@@ -0,0 +1,185 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+// workloadResourceTypes are the kinds of deployment resources which create pods.
+const workloadResourceTypes = "Deployment,StatefulSet,DaemonSet,Job,CronJob"
+
+type podsOptions struct {
+	clustercontext.Context
+
+	release   string
+	namespace string
+}
+
+type podRow struct {
+	Namespace     string                  `json:"namespace"`
+	Name          string                  `json:"name"`
+	Status        string                  `json:"status,omitempty"`
+	Ready         bool                    `json:"ready"`
+	Release       string                  `json:"release,omitempty"`
+	CpuRequest    string                  `json:"cpuRequest,omitempty"`
+	CpuLimit      string                  `json:"cpuLimit,omitempty"`
+	MemoryRequest string                  `json:"memoryRequest,omitempty"`
+	MemoryLimit   string                  `json:"memoryLimit,omitempty"`
+	CreatedAt     string                  `json:"createdAt,omitempty"`
+	Conditions    []pipeline.PodCondition `json:"conditions,omitempty"`
+}
+
+func NewPodsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := podsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "pods [--cluster=ID | [--cluster-name=]NAME]",
+		Aliases: []string{"pod", "po"},
+		Short:   "List the pods of the cluster",
+		Long:    "List the pods of the cluster with their status and resource requests.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runPods(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.release, "deployment", "", "List only the pods of the given Helm deployment (release name)")
+	flags.StringVarP(&options.namespace, "namespace", "n", "", "List only the pods in the given namespace")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list pods of")
+
+	return cmd
+}
+
+func runPods(banzaiCli cli.Cli, options podsOptions, args []string) error {
+	if err := options.Init(args...); err != nil {
+		return errors.WrapIf(err, "failed to initialize options")
+	}
+
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	pods, _, err := client.ClustersApi.GetPodDetails(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get pod details", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to get pod details", "clusterID", clusterID)
+	}
+
+	var workloads []string
+	if options.release != "" {
+		resources, _, err := client.DeploymentsApi.GetDeploymentResource(context.Background(), orgID, clusterID, options.release, &pipeline.GetDeploymentResourceOpts{
+			ResourceTypes: optional.NewString(workloadResourceTypes),
+		})
+		if err != nil {
+			cli.LogAPIError("get deployment resources", err, options.release)
+			return errors.WrapIfWithDetails(err, "failed to get deployment resources", "clusterID", clusterID, "release", options.release)
+		}
+
+		workloads = resourceNames(resources)
+	}
+
+	rows := make([]podRow, 0, len(pods))
+	for _, pod := range pods {
+		if options.namespace != "" && pod.Namespace != options.namespace {
+			continue
+		}
+
+		if options.release != "" && !belongsToRelease(pod, options.release, workloads) {
+			continue
+		}
+
+		rows = append(rows, newPodRow(pod))
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Namespace != rows[j].Namespace {
+			return rows[i].Namespace < rows[j].Namespace
+		}
+
+		return rows[i].Name < rows[j].Name
+	})
+
+	format.PodsWrite(banzaiCli, rows)
+
+	return nil
+}
+
+func newPodRow(pod pipeline.PodItem) podRow {
+	row := podRow{
+		Namespace:     pod.Namespace,
+		Name:          pod.Name,
+		Status:        pod.ResourceSummary.Status,
+		Release:       pod.Labels.Release,
+		CpuRequest:    pod.ResourceSummary.Cpu.Request,
+		CpuLimit:      pod.ResourceSummary.Cpu.Limit,
+		MemoryRequest: pod.ResourceSummary.Memory.Request,
+		MemoryLimit:   pod.ResourceSummary.Memory.Limit,
+		CreatedAt:     pod.CreatedAt,
+		Conditions:    pod.Conditions,
+	}
+
+	for _, condition := range pod.Conditions {
+		if condition.Type == "Ready" {
+			row.Ready = condition.Status == "True"
+		}
+	}
+
+	return row
+}
+
+// resourceNames returns the names of the Kubernetes resources returned for a deployment.
+func resourceNames(resources []map[string]interface{}) []string {
+	var names []string
+	for _, resource := range resources {
+		if name, ok := resource["name"].(string); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// belongsToRelease tells whether a pod was created by a Helm release,
+// either by its release label or by its name being derived from one of the workloads of the release.
+func belongsToRelease(pod pipeline.PodItem, release string, workloads []string) bool {
+	if pod.Labels.Release == release {
+		return true
+	}
+
+	for _, workload := range workloads {
+		if strings.HasPrefix(pod.Name, workload+"-") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestBelongsToRelease(t *testing.T) {
+	workloads := []string{"web-frontend", "web-db"}
+
+	tests := []struct {
+		pod  pipeline.PodItem
+		want bool
+	}{
+		{pod: pipeline.PodItem{Name: "anything", Labels: pipeline.PodItemLabels{Release: "web"}}, want: true},
+		{pod: pipeline.PodItem{Name: "web-frontend-5d8f7b9c4-x2x8q"}, want: true},
+		{pod: pipeline.PodItem{Name: "web-db-0"}, want: true},
+		{pod: pipeline.PodItem{Name: "web-frontend2-0"}, want: false},
+		{pod: pipeline.PodItem{Name: "other-0", Labels: pipeline.PodItemLabels{Release: "other"}}, want: false},
+	}
+
+	for _, test := range tests {
+		if got := belongsToRelease(test.pod, "web", workloads); got != test.want {
+			t.Errorf("belongsToRelease(%q) = %v, want %v", test.pod.Name, got, test.want)
+		}
+	}
+}
+
+func TestNewPodRowReady(t *testing.T) {
+	pod := pipeline.PodItem{
+		Name: "web-0",
+		Conditions: []pipeline.PodCondition{
+			{Type: "PodScheduled", Status: "True"},
+			{Type: "Ready", Status: "False"},
+		},
+	}
+
+	if newPodRow(pod).Ready {
+		t.Error("pod with a false Ready condition must not be ready")
+	}
+
+	pod.Conditions[1].Status = "True"
+	if !newPodRow(pod).Ready {
+		t.Error("pod with a true Ready condition must be ready")
+	}
+}
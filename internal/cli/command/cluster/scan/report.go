@@ -0,0 +1,229 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const (
+	severityHigh    = "high"
+	severityMedium  = "medium"
+	severityUnknown = "unknown"
+	severityNone    = "none"
+)
+
+// severityLevels lists the severities from the most to the least severe.
+var severityLevels = []string{severityHigh, severityMedium, severityUnknown}
+
+type reportOptions struct {
+	clustercontext.Context
+
+	release     string
+	failOn      string
+	maxFindings int
+}
+
+type releaseReport struct {
+	Release  string `json:"release" yaml:"release"`
+	Resource string `json:"resource" yaml:"resource"`
+	Action   string `json:"action" yaml:"action"`
+	Images   string `json:"images" yaml:"images"`
+	High     int    `json:"high" yaml:"high"`
+	Medium   int    `json:"medium" yaml:"medium"`
+	Unknown  int    `json:"unknown" yaml:"unknown"`
+}
+
+type report struct {
+	Releases []releaseReport `json:"releases" yaml:"releases"`
+	Summary  map[string]int  `json:"summary" yaml:"summary"`
+}
+
+func newReportCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := reportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Summarize the security scan results of the cluster",
+		Long: "Summarize the security scan results of the cluster by severity.\n\n" +
+			"Pipeline reports the outcome of the image policy checks of every scanned release. " +
+			"Failed checks and rejected releases are counted as high, warnings as medium, " +
+			"and results that can not be classified as unknown severity findings.\n\n" +
+			"The command exits with a non-zero status if the number of findings at or above the --fail-on severity " +
+			"exceeds --max-findings, so it can be used to gate CI pipelines.",
+		Example: `  banzai cluster scan report
+  banzai cluster scan report --release my-app --fail-on medium --max-findings 0`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runReport(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.release, "release", "", "Only report the scan results of the given release")
+	flags.StringVar(&options.failOn, "fail-on", severityHigh, fmt.Sprintf("Lowest severity counted against the threshold (%s)", strings.Join(severityLevels, "|")))
+	flags.IntVar(&options.maxFindings, "max-findings", 0, "Number of findings at or above the --fail-on severity allowed before exiting with an error, negative value disables the check")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "report")
+
+	return cmd
+}
+
+func runReport(banzaiCli cli.Cli, options reportOptions) error {
+	if !isValidSeverity(options.failOn) {
+		return errors.Errorf("invalid --fail-on severity %q, must be one of %s", options.failOn, strings.Join(severityLevels, ", "))
+	}
+
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	clusterID := options.ClusterID()
+
+	var scans []pipeline.ScanLogItem
+	var err error
+	if options.release != "" {
+		scans, _, err = client.ScanlogApi.ListScansByRelease(context.Background(), orgID, clusterID, options.release)
+	} else {
+		scans, _, err = client.ScanlogApi.ListScans(context.Background(), orgID, clusterID)
+	}
+	if err != nil {
+		cli.LogAPIError("list scan results", err, clusterID)
+		return errors.WrapIfWithDetails(err, "failed to list scan results", "clusterID", clusterID)
+	}
+
+	r := buildReport(scans)
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		format.ScanLogWrite(banzaiCli, r.Releases)
+		fmt.Fprintf(banzaiCli.Out(), "\nFindings: %d high, %d medium, %d unknown\n", r.Summary[severityHigh], r.Summary[severityMedium], r.Summary[severityUnknown])
+	} else {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+		if err := output.Output(ctx, r); err != nil {
+			return errors.WrapIf(err, "failed to write report")
+		}
+	}
+
+	if options.maxFindings >= 0 {
+		if findings := r.countFrom(options.failOn); findings > options.maxFindings {
+			return errors.Errorf("%d findings at or above %s severity exceed the threshold of %d", findings, options.failOn, options.maxFindings)
+		}
+	}
+
+	return nil
+}
+
+func buildReport(scans []pipeline.ScanLogItem) report {
+	r := report{
+		Releases: make([]releaseReport, 0, len(scans)),
+		Summary:  map[string]int{severityHigh: 0, severityMedium: 0, severityUnknown: 0},
+	}
+
+	for _, scan := range scans {
+		images := make([]string, 0, len(scan.Image))
+		for _, image := range scan.Image {
+			images = append(images, image.ImageName+":"+image.ImageTag)
+		}
+
+		rr := releaseReport{
+			Release:  scan.ReleaseName,
+			Resource: scan.Resource,
+			Action:   scan.Action,
+			Images:   strings.Join(images, ", "),
+		}
+
+		for _, result := range scan.Result {
+			switch classifyResult(result) {
+			case severityHigh:
+				rr.High++
+			case severityMedium:
+				rr.Medium++
+			case severityUnknown:
+				rr.Unknown++
+			}
+		}
+
+		// a rejected release is always a high severity finding, even if the reason is not reported
+		if strings.EqualFold(scan.Action, "reject") && rr.High == 0 {
+			rr.High++
+		}
+
+		r.Summary[severityHigh] += rr.High
+		r.Summary[severityMedium] += rr.Medium
+		r.Summary[severityUnknown] += rr.Unknown
+		r.Releases = append(r.Releases, rr)
+	}
+
+	return r
+}
+
+// classifyResult returns the severity of a policy check result reported by the security scan.
+func classifyResult(result string) string {
+	result = strings.ToLower(result)
+
+	switch {
+	case strings.Contains(result, "fail"), strings.Contains(result, "reject"), strings.Contains(result, "stop"):
+		return severityHigh
+	case strings.Contains(result, "warn"):
+		return severityMedium
+	case strings.Contains(result, "success"), strings.Contains(result, "pass"):
+		return severityNone
+	default:
+		return severityUnknown
+	}
+}
+
+// countFrom returns the number of findings at or above the given severity.
+func (r report) countFrom(severity string) int {
+	count := 0
+	for _, level := range severityLevels {
+		count += r.Summary[level]
+		if level == severity {
+			break
+		}
+	}
+
+	return count
+}
+
+func isValidSeverity(severity string) bool {
+	for _, level := range severityLevels {
+		if level == severity {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scan
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type tableContext struct {
+	out bytes.Buffer
+}
+
+func (c *tableContext) Out() io.Writer       { return &c.out }
+func (c *tableContext) Color() bool          { return false }
+func (c *tableContext) OutputFormat() string { return output.OutputFormatDefault }
+
+func TestBuildReport(t *testing.T) {
+	scans := []pipeline.ScanLogItem{
+		{
+			ReleaseName: "flying-monkey",
+			Action:      "allow",
+			Result:      []string{"nginx:latest policy check success", "busybox:latest policy check warning"},
+		},
+		{
+			ReleaseName: "dancing-panda",
+			Action:      "reject",
+			Result:      []string{"nginx:latest policy check failed", "redis:latest something else"},
+		},
+		{
+			ReleaseName: "silent-owl",
+			Action:      "reject",
+		},
+	}
+
+	r := buildReport(scans)
+
+	expected := map[string]int{severityHigh: 2, severityMedium: 1, severityUnknown: 1}
+	for severity, count := range expected {
+		if r.Summary[severity] != count {
+			t.Errorf("expected %d %s findings, got %d", count, severity, r.Summary[severity])
+		}
+	}
+
+	thresholds := map[string]int{severityHigh: 2, severityMedium: 3, severityUnknown: 4}
+	for severity, count := range thresholds {
+		if actual := r.countFrom(severity); actual != count {
+			t.Errorf("expected %d findings at or above %s, got %d", count, severity, actual)
+		}
+	}
+}
+
+func TestReportTableOutput(t *testing.T) {
+	r := buildReport([]pipeline.ScanLogItem{
+		{
+			ReleaseName: "dancing-panda",
+			Resource:    "deployment",
+			Action:      "reject",
+			Result:      []string{"nginx:latest policy check failed", "busybox:latest policy check warning"},
+		},
+	})
+
+	ctx := &tableContext{}
+	format.ScanLogWrite(ctx, r.Releases)
+	table := ctx.out.String()
+
+	if strings.Contains(table, "#(") {
+		t.Fatalf("table contains a broken cell:\n%s", table)
+	}
+
+	lines := strings.Split(strings.TrimSpace(table), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected a header and a single row, got:\n%s", table)
+	}
+
+	for _, column := range []string{"Release", "Resource", "Action", "Images", "High", "Medium", "Unknown"} {
+		if !strings.Contains(lines[0], column) {
+			t.Errorf("expected %s column in the header %q", column, lines[0])
+		}
+	}
+
+	if fields := strings.Fields(lines[1]); len(fields) < 6 || fields[0] != "dancing-panda" || fields[len(fields)-3] != "1" || fields[len(fields)-2] != "1" || fields[len(fields)-1] != "0" {
+		t.Errorf("unexpected row %q", lines[1])
+	}
+}
@@ -27,15 +27,18 @@ import (
 
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/apply"
 	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 )
 
 type updateOptions struct {
 	file     string
-	wait     bool
 	interval int
+	dryRun   bool
+	wait     *wait.Options
 	clustercontext.Context
 }
 
@@ -58,8 +61,10 @@ func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags := cmd.Flags()
 
 	flags.StringVarP(&options.file, "file", "f", "", "Cluster update descriptor file")
-	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for cluster update")
-	flags.IntVarP(&options.interval, "interval", "i", 10, "Interval in seconds for polling cluster status")
+	flags.IntVarP(&options.interval, "interval", "i", 10, "Initial interval in seconds for polling cluster status")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Show how the update descriptor differs from the live state of the cluster without updating it")
+
+	options.wait = wait.NewOptions(cmd)
 
 	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "update")
 
@@ -87,13 +92,15 @@ func runUpdate(banzaiCli cli.Cli, options updateOptions) error {
 
 		format.ClusterWrite(banzaiCli, cluster)
 
-		confirmed := false
-		err = survey.AskOne(&survey.Confirm{Message: "Do you want to UPDATE the cluster?"}, &confirmed)
-		if err != nil {
-			return errors.WrapIf(err, "failed to read cluster update confirmation")
-		}
-		if !confirmed {
-			return errors.New("update cancelled")
+		if !options.dryRun {
+			confirmed := false
+			err = survey.AskOne(&survey.Confirm{Message: "Do you want to UPDATE the cluster?"}, &confirmed)
+			if err != nil {
+				return errors.WrapIf(err, "failed to read cluster update confirmation")
+			}
+			if !confirmed {
+				return errors.New("update cancelled")
+			}
 		}
 
 		var nextVersion string
@@ -130,6 +137,16 @@ func runUpdate(banzaiCli cli.Cli, options updateOptions) error {
 
 	log.Debugf("update request: %#v", request)
 
+	if options.dryRun {
+		cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, id)
+		if err != nil {
+			cli.LogAPIError("get cluster", err, id)
+			return errors.WrapIf(err, "failed to get cluster details")
+		}
+
+		return apply.WriteDescriptorDiff(banzaiCli, apply.KindCluster, cluster.Name, "", request, cluster)
+	}
+
 	_, err = client.ClustersApi.UpdateCluster(context.Background(), orgID, id, request)
 	if err != nil {
 		cli.LogAPIError("update cluster", err, request)
@@ -137,22 +154,12 @@ func runUpdate(banzaiCli cli.Cli, options updateOptions) error {
 	}
 
 	log.Info("cluster is being updated")
-	if options.wait {
-		for {
-			cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, id)
-			if err != nil {
-				cli.LogAPIError("get cluster", err, request)
-			} else {
-				format.ClusterShortWrite(banzaiCli, cluster)
-				if cluster.Status != "UPDATING" {
-					return nil
-				}
-
-				time.Sleep(time.Duration(options.interval) * time.Second)
-			}
-		}
-	} else {
+	if !options.wait.Enabled() {
 		log.Infof("you can check its status with the command `banzai cluster get %q`", options.ClusterName())
+		return nil
 	}
-	return nil
+
+	options.wait.SetInterval(time.Duration(options.interval) * time.Second)
+
+	return waitForCluster(banzaiCli, orgID, id, "cluster update", "UPDATING", options.wait)
 }
@@ -0,0 +1,81 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/deployment"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/feature"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+// NewClusterGroupCommand returns a cobra command for `clustergroup` subcommands.
+func NewClusterGroupCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clustergroup",
+		Aliases: []string{"clustergroups", "cg"},
+		Short:   "Manage cluster groups",
+	}
+
+	cmd.AddCommand(
+		newCreateCommand(banzaiCli),
+		newListCommand(banzaiCli),
+		newGetCommand(banzaiCli),
+		newUpdateCommand(banzaiCli),
+		newDeleteCommand(banzaiCli),
+		deployment.NewDeploymentCommand(banzaiCli),
+		feature.NewFeatureCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// writeClusterGroups writes cluster groups to the output, listing member clusters by name in the default format.
+func writeClusterGroups(banzaiCli cli.Cli, groups []pipeline.ApiClusterGroup) {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.ClusterGroupsWrite(banzaiCli, groups)
+		return
+	}
+
+	type row struct {
+		Id              int32
+		Name            string
+		Members         string
+		EnabledFeatures string
+	}
+
+	table := make([]row, 0, len(groups))
+	for _, group := range groups {
+		members := make([]string, len(group.Members))
+		for i, member := range group.Members {
+			members[i] = member.Name
+		}
+
+		table = append(table, row{
+			Id:              group.Id,
+			Name:            group.Name,
+			Members:         strings.Join(members, ","),
+			EnabledFeatures: strings.Join(group.EnabledFeatures, ","),
+		})
+	}
+
+	format.ClusterGroupsWrite(banzaiCli, table)
+}
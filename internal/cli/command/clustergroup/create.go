@@ -0,0 +1,93 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type createOptions struct {
+	name    string
+	members []string
+}
+
+func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"c"},
+		Short:   "Create a cluster group",
+		Long:    "Create a cluster group from the given member clusters. In interactive mode banzai CLI asks for the missing name and member clusters.",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runCreate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.name, "name", "n", "", "Name of the cluster group")
+	flags.StringSliceVarP(&options.members, "member", "m", nil, "Name of a member cluster (can be repeated)")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if options.name == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("--name must be specified")
+		}
+
+		if err := survey.AskOne(&survey.Input{Message: "Cluster group name:"}, &options.name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to read cluster group name")
+		}
+	}
+
+	members, err := clustercontext.SelectClusters(banzaiCli, options.members, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to select member clusters")
+	}
+
+	request := pipeline.ApiCreateRequest{
+		Name:    options.name,
+		Members: members,
+	}
+
+	log.Debugf("create cluster group request: %#v", request)
+
+	response, _, err := banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsPost(context.Background(), orgID, request)
+	if err != nil {
+		cli.LogAPIError("create cluster group", err, request)
+		return errors.WrapIf(err, "failed to create cluster group")
+	}
+
+	log.Infof("cluster group %q created with ID %d", response.Name, response.Id)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+)
+
+type deleteOptions struct {
+	clustergroupcontext.Context
+}
+
+func newDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [--group=ID | [--group-name=]NAME]",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete a cluster group",
+		Long:    "Delete a cluster group. The member clusters are not deleted. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(args...); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runDelete(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "delete")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	if banzaiCli.Interactive() {
+		group, _, err := client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdGet(context.Background(), orgID, groupID)
+		if err != nil {
+			return errors.WrapIf(err, "failed to get cluster group details")
+		}
+
+		writeClusterGroups(banzaiCli, []pipeline.ApiClusterGroup{group})
+
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the cluster group?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, _, err := client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDelete(context.Background(), orgID, groupID); err != nil {
+		cli.LogAPIError("delete cluster group", err, groupID)
+		return errors.WrapIfWithDetails(err, "failed to delete cluster group", "clusterGroupID", groupID)
+	}
+
+	log.Infof("cluster group %q deleted", options.ClusterGroupName())
+
+	return nil
+}
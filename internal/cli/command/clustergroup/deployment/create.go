@@ -0,0 +1,140 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type deploymentOptions struct {
+	version       string
+	valuesFile    string
+	overridesFile string
+	dryRun        bool
+	atomic        bool
+	rollingMode   bool
+}
+
+type createOptions struct {
+	clustergroupcontext.Context
+	deploymentOptions
+
+	releaseName string
+	namespace   string
+}
+
+func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create CHART",
+		Aliases: []string{"c", "install"},
+		Short:   "Deploy a Helm chart to every member of the cluster group",
+		Long:    "Deploy a Helm chart (for example stable/nginx-ingress) to every member cluster of the cluster group. Values can be given in a JSON or YAML file, and per-cluster overrides in a file containing a map of cluster names to values.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runCreate(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.releaseName, "release-name", "", "Name of the release (generated if not specified)")
+	flags.StringVarP(&options.namespace, "namespace", "n", "", "Namespace to deploy the chart to")
+	options.deploymentOptions.addFlags(cmd)
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "deploy to")
+
+	return cmd
+}
+
+func (o *deploymentOptions) addFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVar(&o.version, "version", "", "Chart version (the latest version is used if not specified)")
+	flags.StringVarP(&o.valuesFile, "file", "f", "", "Values file of the chart (use - to read from the standard input)")
+	flags.StringVar(&o.overridesFile, "overrides", "", "File with values overrides keyed by member cluster name")
+	flags.BoolVar(&o.dryRun, "dry-run", false, "Simulate the deployment")
+	flags.BoolVar(&o.atomic, "atomic", false, "Roll back the deployment on a member cluster if it fails there")
+	flags.BoolVar(&o.rollingMode, "rolling-mode", false, "Deploy to member clusters one after the other instead of in parallel")
+}
+
+// buildRequest builds a cluster group deployment request from the options.
+func (o *deploymentOptions) buildRequest(chart string) (pipeline.DeploymentClusterGroupDeployment, error) {
+	request := pipeline.DeploymentClusterGroupDeployment{
+		Name:        chart,
+		Version:     o.version,
+		Dryrun:      o.dryRun,
+		Atomic:      o.atomic,
+		RollingMode: o.rollingMode,
+	}
+
+	if o.valuesFile != "" {
+		values, err := readValues(o.valuesFile)
+		if err != nil {
+			return request, errors.WrapIf(err, "failed to read values")
+		}
+		request.Values = values
+	}
+
+	if o.overridesFile != "" {
+		overrides, err := readValues(o.overridesFile)
+		if err != nil {
+			return request, errors.WrapIf(err, "failed to read value overrides")
+		}
+		request.ValueOverrides = overrides
+	}
+
+	return request, nil
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, chart string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	request, err := options.buildRequest(chart)
+	if err != nil {
+		return err
+	}
+	request.ReleaseName = options.releaseName
+	request.Namespace = options.namespace
+
+	log.Debugf("create cluster group deployment request: %#v", request)
+
+	response, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsPost(context.Background(), orgID, groupID, request)
+	if err != nil {
+		cli.LogAPIError("create cluster group deployment", err, request)
+		return errors.WrapIfWithDetails(err, "failed to create cluster group deployment", "clusterGroupID", groupID, "chart", chart)
+	}
+
+	log.Infof("deployment %q is being deployed to cluster group %q", response.ReleaseName, options.ClusterGroupName())
+	format.TargetClustersWrite(banzaiCli, response.TargetClusters)
+
+	return nil
+}
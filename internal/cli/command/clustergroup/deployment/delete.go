@@ -0,0 +1,103 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/antihax/optional"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type deleteOptions struct {
+	clustergroupcontext.Context
+
+	force bool
+}
+
+func newDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [RELEASE]",
+		Aliases: []string{"del", "rm", "uninstall"},
+		Short:   "Delete a cluster group deployment",
+		Long:    "Delete a cluster group deployment from every member cluster. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.force, "force", "f", false, "Delete the cluster group deployment even if it can not be deleted from some member clusters")
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "delete deployment of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	releaseName, err := getReleaseName(banzaiCli, orgID, groupID, args)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the deployment from every member cluster?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	status, _, err := client.ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameDelete(
+		context.Background(),
+		orgID,
+		groupID,
+		releaseName,
+		&pipeline.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameDeleteOpts{Force: optional.NewBool(options.force)},
+	)
+	if err != nil {
+		cli.LogAPIError("delete cluster group deployment", err, releaseName)
+		return errors.WrapIfWithDetails(err, "failed to delete cluster group deployment", "clusterGroupID", groupID, "release", releaseName)
+	}
+
+	log.Infof("deployment %q deleted from cluster group %q", releaseName, options.ClusterGroupName())
+	if status.ClusterName != "" {
+		format.TargetClustersWrite(banzaiCli, []pipeline.DeploymentTargetClusterStatus{status})
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type syncOptions struct {
+	clustergroupcontext.Context
+}
+
+func newSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := syncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync [RELEASE]",
+		Short: "Synchronize a cluster group deployment to the member clusters",
+		Long:  "Install or upgrade the deployment on member clusters where it is missing or stale, and delete it from clusters that are no longer members of the group.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runSync(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "sync deployment of")
+
+	return cmd
+}
+
+func runSync(banzaiCli cli.Cli, options syncOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	releaseName, err := getReleaseName(banzaiCli, orgID, groupID, args)
+	if err != nil {
+		return err
+	}
+
+	status, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameSyncPut(context.Background(), orgID, groupID, releaseName)
+	if err != nil {
+		cli.LogAPIError("sync cluster group deployment", err, releaseName)
+		return errors.WrapIfWithDetails(err, "failed to sync cluster group deployment", "clusterGroupID", groupID, "release", releaseName)
+	}
+
+	log.Infof("deployment %q is being synchronized in cluster group %q", releaseName, options.ClusterGroupName())
+	if status.ClusterName != "" {
+		format.TargetClustersWrite(banzaiCli, []pipeline.DeploymentTargetClusterStatus{status})
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type updateOptions struct {
+	clustergroupcontext.Context
+	deploymentOptions
+
+	chart       string
+	reuseValues bool
+}
+
+func newUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update [RELEASE]",
+		Aliases: []string{"u", "upgrade"},
+		Short:   "Update a cluster group deployment",
+		Long:    "Update a cluster group deployment on every member cluster. The chart of the deployment must be given with the --chart flag.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if options.chart == "" {
+				cmd.SilenceUsage = false
+				return errors.New("--chart flag must be specified")
+			}
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runUpdate(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.chart, "chart", "", "Chart of the deployment (for example stable/nginx-ingress)")
+	flags.BoolVar(&options.reuseValues, "reuse-values", false, "Reuse the values of the current release and merge the given values into them")
+	options.deploymentOptions.addFlags(cmd)
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "update deployment of")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	releaseName, err := getReleaseName(banzaiCli, orgID, groupID, args)
+	if err != nil {
+		return err
+	}
+
+	current, _, err := client.ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameGet(context.Background(), orgID, groupID, releaseName)
+	if err != nil {
+		cli.LogAPIError("get cluster group deployment", err, releaseName)
+		return errors.WrapIfWithDetails(err, "failed to get cluster group deployment", "clusterGroupID", groupID, "release", releaseName)
+	}
+
+	request, err := options.buildRequest(options.chart)
+	if err != nil {
+		return err
+	}
+	request.ReleaseName = releaseName
+	request.Namespace = current.Namespace
+	request.ReuseValues = options.reuseValues
+
+	log.Debugf("update cluster group deployment request: %#v", request)
+
+	response, _, err := client.ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNamePut(context.Background(), orgID, groupID, releaseName, request)
+	if err != nil {
+		cli.LogAPIError("update cluster group deployment", err, request)
+		return errors.WrapIfWithDetails(err, "failed to update cluster group deployment", "clusterGroupID", groupID, "release", releaseName)
+	}
+
+	log.Infof("deployment %q is being updated in cluster group %q", response.ReleaseName, options.ClusterGroupName())
+	format.TargetClustersWrite(banzaiCli, response.TargetClusters)
+
+	return nil
+}
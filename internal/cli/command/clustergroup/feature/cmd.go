@@ -0,0 +1,58 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feature
+
+import (
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewFeatureCommand returns a cobra command for `clustergroup feature` subcommands.
+func NewFeatureCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "feature",
+		Aliases: []string{"features", "f"},
+		Short:   "Manage cluster group features",
+		Long:    "Manage features (for example service mesh or multi-cluster deployments) that span every member cluster of a cluster group.",
+	}
+
+	cmd.AddCommand(
+		newListCommand(banzaiCli),
+		newGetCommand(banzaiCli),
+		newEnableCommand(banzaiCli),
+		newUpdateCommand(banzaiCli),
+		newDisableCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// readProperties reads feature properties in JSON or YAML format from a file or from the standard input.
+func readProperties(filePath string) (map[string]interface{}, error) {
+	filename, raw, err := utils.ReadFileOrStdin(filePath)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+	}
+
+	properties := make(map[string]interface{})
+	if err := utils.Unmarshal(raw, &properties); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to unmarshal feature properties", "filename", filename)
+	}
+
+	return properties, nil
+}
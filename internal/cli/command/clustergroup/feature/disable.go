@@ -0,0 +1,80 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feature
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+)
+
+type disableOptions struct {
+	clustergroupcontext.Context
+}
+
+func newDisableCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := disableOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "disable FEATURE",
+		Aliases: []string{"d", "deactivate", "off"},
+		Short:   "Disable a feature on the cluster group",
+		Long:    "Disable a feature on the cluster group. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runDisable(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "disable feature on")
+
+	return cmd
+}
+
+func runDisable(banzaiCli cli.Cli, options disableOptions, featureName string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to DISABLE the feature on every member cluster?"}, &confirmed)
+		if !confirmed {
+			return errors.New("disabling cancelled")
+		}
+	}
+
+	_, _, err := banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNameDelete(context.Background(), orgID, groupID, featureName)
+	if err != nil {
+		cli.LogAPIError("disable cluster group feature", err, featureName)
+		return errors.WrapIfWithDetails(err, "failed to disable cluster group feature", "clusterGroupID", groupID, "feature", featureName)
+	}
+
+	log.Infof("feature %q is being disabled on cluster group %q", featureName, options.ClusterGroupName())
+
+	return nil
+}
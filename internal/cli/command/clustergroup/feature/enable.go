@@ -0,0 +1,81 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feature
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+)
+
+type enableOptions struct {
+	clustergroupcontext.Context
+
+	filePath string
+}
+
+func newEnableCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := enableOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "enable FEATURE",
+		Aliases: []string{"e", "activate", "on"},
+		Short:   "Enable a feature on the cluster group",
+		Long:    "Enable a feature on the cluster group. The properties of the feature are read from a JSON or YAML file, or from the standard input.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runEnable(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filePath, "file", "f", "", "Feature properties file")
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "enable feature on")
+
+	return cmd
+}
+
+func runEnable(banzaiCli cli.Cli, options enableOptions, featureName string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	properties, err := readProperties(options.filePath)
+	if err != nil {
+		return errors.WrapIf(err, "failed to read feature properties")
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNamePost(context.Background(), orgID, groupID, featureName, properties)
+	if err != nil {
+		cli.LogAPIError("enable cluster group feature", err, properties)
+		return errors.WrapIfWithDetails(err, "failed to enable cluster group feature", "clusterGroupID", groupID, "feature", featureName)
+	}
+
+	log.Infof("feature %q is being enabled on cluster group %q", featureName, options.ClusterGroupName())
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+)
+
+type updateOptions struct {
+	clustergroupcontext.Context
+
+	newName string
+	members []string
+}
+
+func newUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update [--group=ID | [--group-name=]NAME]",
+		Aliases: []string{"u"},
+		Short:   "Update the name or the member clusters of a cluster group",
+		Long:    "Update the name or the member clusters of a cluster group. The given member clusters replace the current ones. In interactive mode banzai CLI offers the current members as default selection.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			if err := options.Init(args...); err != nil {
+				return errors.WrapIf(err, "failed to initialize options")
+			}
+
+			return runUpdate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.newName, "new-name", "", "New name of the cluster group")
+	flags.StringSliceVarP(&options.members, "member", "m", nil, "Name of a member cluster (can be repeated)")
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "update")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+	groupID := options.ClusterGroupID()
+
+	group, _, err := client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdGet(context.Background(), orgID, groupID)
+	if err != nil {
+		cli.LogAPIError("get cluster group", err, groupID)
+		return errors.WrapIfWithDetails(err, "failed to get cluster group", "clusterGroupID", groupID)
+	}
+
+	request := pipeline.ApiUpdateRequest{
+		Name: group.Name,
+	}
+
+	if options.newName != "" {
+		request.Name = options.newName
+	}
+
+	if len(options.members) == 0 && !banzaiCli.Interactive() {
+		for _, member := range group.Members {
+			request.Members = append(request.Members, member.Id)
+		}
+	} else {
+		current := make([]string, len(group.Members))
+		for i, member := range group.Members {
+			current[i] = member.Name
+		}
+
+		request.Members, err = clustercontext.SelectClusters(banzaiCli, options.members, current)
+		if err != nil {
+			return errors.WrapIf(err, "failed to select member clusters")
+		}
+	}
+
+	log.Debugf("update cluster group request: %#v", request)
+
+	response, _, err := client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdPut(context.Background(), orgID, groupID, request)
+	if err != nil {
+		cli.LogAPIError("update cluster group", err, request)
+		return errors.WrapIfWithDetails(err, "failed to update cluster group", "clusterGroupID", groupID)
+	}
+
+	log.Infof("cluster group %q updated", response.Name)
+
+	return nil
+}
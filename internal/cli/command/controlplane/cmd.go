@@ -29,9 +29,12 @@ func NewControlPlaneCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	cmd.AddCommand(
 		NewUpCommand(banzaiCli),
+		NewPlanCommand(banzaiCli),
 		NewDownCommand(banzaiCli),
 		NewInitCommand(banzaiCli),
 		NewDebugCommand(banzaiCli),
+		NewStatusCommand(banzaiCli),
+		NewWorkspaceCommand(banzaiCli),
 	)
 
 	return cmd
@@ -15,18 +15,34 @@
 package controlplane
 
 import (
+	"context"
+	"strings"
+
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/login"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 type destroyOptions struct {
+	forceOrphanClusters bool
 	*cpContext
 }
 
+type managedCluster struct {
+	Organization string
+	Id           int32
+	Name         string
+	Distribution string
+	Location     string
+	Status       string
+}
+
 // NewDownCommand creates a new cobra.Command for `banzai controlplane down`.
 func NewDownCommand(banzaiCli cli.Cli) *cobra.Command {
 	options := destroyOptions{}
@@ -34,8 +50,10 @@ func NewDownCommand(banzaiCli cli.Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "down",
 		Short: "Destroy the controlplane",
-		Long:  "Destroy a controlplane based on json stdin or interactive session",
-		Args:  cobra.NoArgs,
+		Long: "Destroy a controlplane based on json stdin or interactive session.\n\n" +
+			"The command refuses to destroy a controlplane which still manages clusters, as they would be orphaned. " +
+			"The clusters are listed through the Pipeline API of the instance, which may require logging in.",
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceErrors = true
 			cmd.SilenceUsage = true
@@ -46,6 +64,9 @@ func NewDownCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	options.cpContext = NewContext(cmd, banzaiCli)
 
+	flags := cmd.Flags()
+	flags.BoolVar(&options.forceOrphanClusters, "force-orphan-clusters", false, "Destroy the controlplane even if it still manages clusters, or if they can not be checked")
+
 	return cmd
 }
 
@@ -59,6 +80,14 @@ func runDestroy(options destroyOptions, banzaiCli cli.Cli) error {
 		return err
 	}
 
+	if err := checkManagedClusters(options, banzaiCli); err != nil {
+		if !options.forceOrphanClusters {
+			return err
+		}
+
+		log.Warnf("%v, destroying anyway", err)
+	}
+
 	if banzaiCli.Interactive() {
 		var destroy bool
 		_ = survey.AskOne(
@@ -79,8 +108,6 @@ func runDestroy(options destroyOptions, banzaiCli cli.Cli) error {
 		return err
 	}
 
-	// TODO: check if there are any clusters are created with the pipeline instance
-
 	log.Info("controlplane is being destroyed")
 	switch values["provider"] {
 	case providerEc2:
@@ -145,3 +172,86 @@ func runDestroy(options destroyOptions, banzaiCli cli.Cli) error {
 
 	return nil
 }
+
+// checkManagedClusters returns an error if the Pipeline instance of the workspace still manages clusters, or if it can not be checked.
+// The clusters found are written to the output.
+func checkManagedClusters(options destroyOptions, banzaiCli cli.Cli) error {
+	address, err := options.readExternalAddress()
+	if err != nil {
+		log.Debugf("no external address found, the controlplane has not been deployed: %v", err)
+		return nil
+	}
+
+	endpoint := address + "pipeline"
+	log.Infof("checking clusters managed by the Pipeline instance at %s", endpoint)
+
+	if _, _, err := cli.CheckPipelineEndpoint(endpoint); err != nil {
+		return errors.WrapIf(err, "failed to check the clusters managed by Pipeline")
+	}
+
+	if strings.TrimSuffix(viper.GetString("pipeline.basepath"), "/") != strings.TrimSuffix(endpoint, "/") || viper.GetString("pipeline.token") == "" {
+		if !banzaiCli.Interactive() {
+			return errors.Errorf("failed to check the clusters managed by Pipeline: not logged in to %s, run `banzai login --endpoint=%q` first", endpoint, endpoint)
+		}
+
+		log.Info("please log in to check the clusters managed by Pipeline")
+		if err := login.Login(banzaiCli, endpoint, "", false, false); err != nil {
+			return errors.WrapIf(err, "failed to check the clusters managed by Pipeline")
+		}
+	}
+
+	clusters, err := listManagedClusters(banzaiCli)
+	if err != nil {
+		return errors.WrapIf(err, "failed to check the clusters managed by Pipeline")
+	}
+
+	if len(clusters) == 0 {
+		return nil
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"Organization", "Id", "Name", "Distribution", "Location", "Status"},
+	}
+
+	if err := output.Output(ctx, clusters); err != nil {
+		return err
+	}
+
+	return errors.Errorf("the controlplane still manages %d cluster(s), which would be orphaned. Delete them first, or pass --force-orphan-clusters", len(clusters))
+}
+
+// listManagedClusters returns the clusters of all the organizations of the user.
+func listManagedClusters(banzaiCli cli.Cli) ([]managedCluster, error) {
+	client := banzaiCli.Client()
+
+	orgs, _, err := client.OrganizationsApi.ListOrgs(context.Background())
+	if err != nil {
+		cli.LogAPIError("list organizations", err, nil)
+		return nil, errors.WrapIf(err, "failed to list organizations")
+	}
+
+	clusters := make([]managedCluster, 0)
+	for _, org := range orgs {
+		orgClusters, _, err := client.ClustersApi.ListClusters(context.Background(), org.Id)
+		if err != nil {
+			cli.LogAPIError("list clusters", err, org.Id)
+			return nil, errors.WrapIff(err, "failed to list clusters of organization %q", org.Name)
+		}
+
+		for _, cluster := range orgClusters {
+			clusters = append(clusters, managedCluster{
+				Organization: org.Name,
+				Id:           cluster.Id,
+				Name:         cluster.Name,
+				Distribution: cluster.Distribution,
+				Location:     cluster.Location,
+				Status:       cluster.Status,
+			})
+		}
+	}
+
+	return clusters, nil
+}
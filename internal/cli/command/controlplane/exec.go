@@ -18,7 +18,6 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
-	"encoding/base64"
 	"fmt"
 	"io"
 	"os"
@@ -31,12 +30,6 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-const (
-	runtimeDocker     = "docker"
-	runtimeContainerd = "containerd"
-	runtimeExec       = "exec"
-)
-
 func runTerraform(command string, options *cpContext, env map[string]string, targets ...string) error {
 	err := options.ensureImagePulled()
 	if err != nil {
@@ -84,72 +77,44 @@ func runTerraform(command string, options *cpContext, env map[string]string, tar
 	return runTerraformCommandGeneric(options, cmd, cmdEnv)
 }
 
-// runLocally runs the given command locally (for development)
-func runLocally(command []string, cmdOpt func(*exec.Cmd) error) error {
-	log.Info(strings.Join(command, " "))
-
-	cmd := exec.Command(command[0], command[1:]...)
-	if cmdOpt != nil {
-		if err := cmdOpt(cmd); err != nil {
-			return errors.WrapIf(err, "failed to add optional command options")
-		}
-	}
-
-	return errors.WithStack(cmd.Run())
-}
-
-func runContainer(command []string, options *cpContext, extraArgs []string, cmdOpt func(*exec.Cmd) error) error {
-	args := []string{
-		"run", "--rm", "--net-host",
-		// fmt.Sprintf("--user=%d", os.Getuid()), // TODO
-	}
-
-	if len(extraArgs) > 0 {
-		args = append(args, extraArgs...)
+// runTerraformPlan runs terraform plan and saves the plan to the given file of the workspace.
+func runTerraformPlan(options *cpContext, env map[string]string, planFile string) error {
+	if err := options.ensureImagePulled(); err != nil {
+		return errors.WrapIf(err, "failed to pull cp-installer")
 	}
 
-	args = append(append(args, options.installerImage(), "banzai-cp-installer"), command...)
-
-	ctrCmd, err := lookupTool("ctr")
-	if err != nil {
-		return err
+	cmd := []string{
+		"terraform", "plan",
+		"-input=false",
+		"-out", containerWorkspacePath(planFile),
+		"-var", "workdir=/workspace",
+		fmt.Sprintf("-refresh=%v", options.refreshState),
 	}
 
-	log.Info("ctr ", strings.Join(args, " "))
-
-	cmd := exec.Command(ctrCmd, args...)
+	return runTerraformCommandGeneric(options, cmd, env)
+}
 
-	if cmdOpt != nil {
-		if err := cmdOpt(cmd); err != nil {
-			return errors.WrapIf(err, "failed to add optional command options")
-		}
-	}
+// runTerraformShowPlan writes the JSON representation of a plan file of the workspace to another file of the workspace.
+func runTerraformShowPlan(options *cpContext, env map[string]string, planFile, jsonFile string) error {
+	cmd := []string{"sh", "-c", fmt.Sprintf("terraform show -json %s > %s", containerWorkspacePath(planFile), containerWorkspacePath(jsonFile))}
 
-	return errors.WithStack(cmd.Run())
+	return runTerraformCommandGeneric(options, cmd, env)
 }
 
-func runDocker(command []string, options *cpContext, extraArgs []string, cmdOpt func(*exec.Cmd) error) error {
-	args := []string{
-		"run", "--rm", "--net=host",
-		fmt.Sprintf("--user=%d", os.Getuid()),
-	}
-
-	if len(extraArgs) > 0 {
-		args = append(args, extraArgs...)
+// runTerraformApplyPlan applies a plan file of the workspace created by runTerraformPlan.
+func runTerraformApplyPlan(options *cpContext, env map[string]string, planFile string) error {
+	if err := options.ensureImagePulled(); err != nil {
+		return errors.WrapIf(err, "failed to pull cp-installer")
 	}
 
-	args = append(append(args, options.installerImage()), command...)
-
-	log.Info("docker ", strings.Join(args, " "))
+	cmd := []string{"terraform", "apply", "-input=false", containerWorkspacePath(planFile)}
 
-	cmd := exec.Command("docker", args...)
-	if cmdOpt != nil {
-		if err := cmdOpt(cmd); err != nil {
-			return errors.WrapIf(err, "failed to add optional command options")
-		}
-	}
+	return runTerraformCommandGeneric(options, cmd, env)
+}
 
-	return errors.WithStack(cmd.Run())
+// containerWorkspacePath returns the path of a file of the workspace as seen by the installer.
+func containerWorkspacePath(name string) string {
+	return "/workspace/" + filepath.ToSlash(name)
 }
 
 func pullImage(options *cpContext, _ cli.Cli) error {
@@ -163,39 +128,13 @@ func pullImage(options *cpContext, _ cli.Cli) error {
 		return nil
 	}
 
-	var args []string
-
-	tool := options.containerRuntime
-	switch options.containerRuntime {
-	case runtimeDocker:
-		args = []string{"pull"}
-	case runtimeContainerd:
-		tool = "ctr"
-		args = []string{"image", "pull"}
-
-	case runtimeExec:
+	if options.runtime.name() == runtimeExec {
 		return nil
-	default:
-		return errors.Errorf("unknown container runtime: %q", options.containerRuntime)
 	}
 
-	tool, err := lookupTool(tool)
-	if err != nil {
-		return err
-	}
-
-	args = append(args, img)
 	log.Info("Pulling Banzai Cloud Pipeline installer image...")
 
-	log.Info(tool, " ", strings.Join(args, " "))
-
-	cmd := exec.Command(tool, args...)
-
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	return cmd.Run()
+	return options.runtime.pull(img)
 }
 
 func combineOutput(output string, err error) string {
@@ -210,65 +149,35 @@ func combineOutput(output string, err error) string {
 
 func runContainerCommand(options *cpContext, cmd []string, cmdEnv map[string]string) (string, error) {
 	buffer := new(bytes.Buffer)
-	cmdOpt := func(cmd *exec.Cmd) error {
-		cmd.Stdout = buffer
-		cmd.Stderr = buffer
-		cmd.Env = os.Environ()
-		for key, value := range cmdEnv {
-			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
-		}
-		return nil
-	}
 
-	var err error
-	switch options.containerRuntime {
-	case runtimeExec:
-		err = runLocally(cmd, cmdOpt)
-	case runtimeDocker:
-		args := []string{
-			"-v", fmt.Sprintf("%s:/workspace", options.workspace),
-		}
-		for key := range cmdEnv {
-			args = append(args, "-e", key)
-		}
-		err = runDocker(cmd, options, args, cmdOpt)
-	case runtimeContainerd:
-		args := []string{
-			"--mount", fmt.Sprintf("type=bind,src=%s,dst=/workspace,options=rbind:rw", options.workspace),
-		}
-		for key, value := range cmdEnv {
-			args = append(args, "--env", fmt.Sprintf("%s=%s", key, value)) // env propagation does not work with ctr
-		}
-		err = runContainer(cmd, options, args, cmdOpt)
-	default:
-		err = errors.Errorf("unknown container runtime: %q", options.containerRuntime)
-	}
+	err := runContainerCommandGeneric(options, cmd, runOptions{
+		mounts: []mount{{source: options.workspace, target: "/workspace"}},
+		env:    cmdEnv,
+		stdout: buffer,
+		stderr: buffer,
+	})
 
 	return buffer.String(), err
 }
 
-func readFilesFromContainerToMemory(options *cpContext, source string) (map[string][]byte, error) {
-	// create gzipped archive (cz) and follow symlinks (h)
-	cmd := []string{"sh", "-c", fmt.Sprintf("tar czh %s | base64", source)}
+// runContainerCommandOutput runs a command in the installer container and returns its standard output and error separately,
+// so the output can be parsed even if the command writes warnings to the standard error.
+func runContainerCommandOutput(options *cpContext, cmd []string, cmdEnv map[string]string) (string, string, error) {
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
 
-	var err error
+	err := runContainerCommandGeneric(options, cmd, runOptions{
+		mounts: []mount{{source: options.workspace, target: "/workspace"}},
+		env:    cmdEnv,
+		stdout: stdout,
+		stderr: stderr,
+	})
 
-	buffer := new(bytes.Buffer)
-	cmdOpt := func(cmd *exec.Cmd) error {
-		cmd.Stdout = buffer
-		return nil
-	}
+	return stdout.String(), stderr.String(), err
+}
 
-	err = runContainerCommandGeneric(options, cmd, nil, cmdOpt)
-	if err == nil {
-		decoder := base64.NewDecoder(base64.StdEncoding, buffer)
-		tarContents, err := untarInMemory(decoder)
-		if err != nil {
-			return nil, errors.WrapIf(err, "failed to untar source")
-		}
-		return tarContents, nil
-	}
-	return nil, errors.WrapIf(err, "failed to run container command")
+func readFilesFromContainerToMemory(options *cpContext, source string) (map[string][]byte, error) {
+	return options.runtime.copyOut(options.installerImage(), source)
 }
 
 func untarInMemory(reader io.Reader) (map[string][]byte, error) {
@@ -309,93 +218,56 @@ func runTerraformCommandGeneric(options *cpContext, cmd []string, cmdEnv map[str
 		defer logFile.Close()
 	}
 
-	cmdOpt := func(cmd *exec.Cmd) error {
-		cmd.Env = os.Environ()
-		for key, value := range cmdEnv {
-			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
-		}
-		cmd.Stdin = os.Stdin
-		if options.containerRuntime == runtimeContainerd || logFile == nil {
-			cmd.Stdout = os.Stdout
-			cmd.Stderr = os.Stderr
-		} else {
-			cmd.Stdout = io.MultiWriter(logFile, os.Stdout)
-			cmd.Stderr = io.MultiWriter(logFile, os.Stderr)
-		}
+	opts := runOptions{
+		mounts: []mount{
+			{source: options.workspace, target: "/workspace"},
+			{source: options.workspace + "/state.tf.json", target: "/terraform/state.tf.json"},
+			{source: options.workspace + "/.terraform/terraform.tfstate", target: "/terraform/.terraform/terraform.tfstate"},
+		},
+		env:    cmdEnv,
+		tty:    options.banzaiCli.Interactive(),
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+	}
 
-		return nil
+	if options.explicitState && options.tfstateExists() {
+		opts.mounts = append(opts.mounts, mount{source: options.tfstatePath(), target: "/terraform/terraform.tfstate"})
 	}
 
-	switch options.containerRuntime {
-	case runtimeExec:
-		return runLocally(cmd, cmdOpt)
-	case runtimeDocker:
-		args := []string{
-			"-v", fmt.Sprintf("%s:/workspace", options.workspace),
-			"-v", fmt.Sprintf("%s:/terraform/state.tf.json", options.workspace+"/state.tf.json"),
-			"-v", fmt.Sprintf("%s:/terraform/.terraform/terraform.tfstate", options.workspace+"/.terraform/terraform.tfstate"),
-		}
-		if options.explicitState && options.tfstateExists() {
-			args = append(args, "-v", fmt.Sprintf("%s:/terraform/terraform.tfstate", options.tfstatePath()))
-		}
-		if options.banzaiCli.Interactive() {
-			args = append(args, "-ti")
-		}
-		for key := range cmdEnv {
-			args = append(args, "-e", key)
-		}
-		return runDocker(cmd, options, args, cmdOpt)
-	case runtimeContainerd:
-		args := []string{
-			"--mount", fmt.Sprintf("type=bind,src=%s,dst=/workspace,options=rbind:rw", options.workspace),
-			"--mount", fmt.Sprintf("type=bind,src=%s,dst=/terraform/state.tf.json,options=rbind:rw", options.workspace+"/state.tf.json"),
-			"--mount", fmt.Sprintf("type=bind,src=%s,dst=/terraform/.terraform/terraform.tfstate,options=rbind:rw", options.workspace+"/.terraform/terraform.tfstate"),
-		}
-		if options.explicitState && options.tfstateExists() {
-			args = append(args, "--mount", fmt.Sprintf("type=bind,src=%s,dst=/terraform/terraform.tfstate,options=rbind:rw", options.tfstatePath()))
-		}
-		if options.banzaiCli.Interactive() {
-			args = append(args, "-t")
-		}
-		for key, value := range cmdEnv {
-			args = append(args, "--env", fmt.Sprintf("%s=%s", key, value)) // env propagation does not work with ctr
+	if options.runtime.name() != runtimeContainerd {
+		if logFile != nil {
+			opts.stdout = io.MultiWriter(logFile, os.Stdout)
+			opts.stderr = io.MultiWriter(logFile, os.Stderr)
 		}
 
-		var cmdLine string
-		for _, word := range cmd {
-			cmdLine += fmt.Sprintf("%q ", word)
-		}
-		cmdLine += "2>&1 | tee /workspace/.out"
-		cmd = []string{"sh", "-o", "pipefail", "-c", cmdLine}
+		return runContainerCommandGeneric(options, cmd, opts)
+	}
 
-		containerErr := runContainer(cmd, options, args, cmdOpt)
+	// the output of ctr can not be captured with a terminal attached, so it is copied to the workspace in the container
+	var cmdLine string
+	for _, word := range cmd {
+		cmdLine += fmt.Sprintf("%q ", word)
+	}
+	cmdLine += "2>&1 | tee /workspace/.out"
+	cmd = []string{"sh", "-o", "pipefail", "-c", cmdLine}
 
-		outpath := filepath.Join(options.workspace, ".out")
-		if logFile != nil {
-			f, err := os.Open(outpath)
-			if err == nil {
-				_, _ = io.Copy(logFile, f)
-				_ = f.Close()
-			}
+	containerErr := runContainerCommandGeneric(options, cmd, opts)
+
+	outpath := filepath.Join(options.workspace, ".out")
+	if logFile != nil {
+		f, err := os.Open(outpath)
+		if err == nil {
+			_, _ = io.Copy(logFile, f)
+			_ = f.Close()
 		}
-		_ = os.Remove(outpath)
-		return containerErr
-	default:
-		return errors.Errorf("unknown container runtime: %q", options.containerRuntime)
 	}
+	_ = os.Remove(outpath)
+	return containerErr
 }
 
-func runContainerCommandGeneric(options *cpContext, cmd []string, args []string, cmdOpt func(*exec.Cmd) error) error {
-	switch options.containerRuntime {
-	case runtimeExec:
-		return runLocally(cmd, cmdOpt)
-	case runtimeDocker:
-		return runDocker(cmd, options, args, cmdOpt)
-	case runtimeContainerd:
-		return runContainer(cmd, options, args, cmdOpt)
-	default:
-		return errors.Errorf("unknown container runtime: %q", options.containerRuntime)
-	}
+func runContainerCommandGeneric(options *cpContext, cmd []string, opts runOptions) error {
+	return options.runtime.run(options.installerImage(), cmd, opts)
 }
 
 func lookupTool(tool string) (string, error) {
@@ -17,7 +17,6 @@ package controlplane
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -370,14 +369,15 @@ func runInit(options initOptions, banzaiCli cli.Cli) error {
 	if err != nil {
 		return errors.WrapIf(err, "failed to pull installer image")
 	}
-	if options.installerTag == latestTag && options.containerRuntime == runtimeDocker {
-		ref, err := exec.Command("docker", "inspect", "-f", "{{index .RepoDigests 0}}", options.installerImage()).Output()
+	installer["image"] = options.installerImage()
+	if options.installerTag == latestTag {
+		info, err := options.runtime.inspect(options.installerImage())
 		if err != nil {
 			return errors.WrapIf(err, "failed to determine installer image hash")
 		}
-		installer["image"] = strings.TrimSpace(string(ref))
-	} else {
-		installer["image"] = options.installerImage()
+		if info.RepoDigest != "" {
+			installer["image"] = info.RepoDigest
+		}
 	}
 
 	out["installer"] = installer
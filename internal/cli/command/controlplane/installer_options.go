@@ -41,6 +41,7 @@ const (
 	traefikAddressFilename  = "traefik-address"
 	externalAddressFilename = "external-address"
 	tfstateFilename         = "terraform.tfstate"
+	planFilename            = "pipeline.tfplan"
 	logsDir                 = "logs"
 	defaultImage            = "docker.io/banzaicloud/pipeline-installer"
 	latestTag               = "latest"
@@ -50,6 +51,7 @@ type cpContext struct {
 	installerTag        string
 	installerImageRepo  string
 	containerRuntime    string
+	runtime             containerRuntime
 	refreshState        bool
 	pullInstaller       bool
 	autoApprove         bool
@@ -75,7 +77,7 @@ func NewContext(cmd *cobra.Command, banzaiCli cli.Cli) *cpContext {
 	ctx.flags.BoolVar(&ctx.autoApprove, "auto-approve", true, "Automatically approve the changes to deploy")
 	ctx.flags.BoolVar(&ctx.logOutput, "log-output", true, "Log output of terraform calls")
 	ctx.flags.StringVar(&ctx.workspace, "workspace", "", "Name of directory for storing the applied configuration and deployment status")
-	ctx.flags.StringVar(&ctx.containerRuntime, "container-runtime", "auto", `Run the terraform command with "docker", "containerd" (crictl) or "exec" (execute locally)`)
+	ctx.flags.StringVar(&ctx.containerRuntime, "container-runtime", "auto", `Run the terraform command with "docker", "podman" (rootless), "containerd" (ctr) or "exec" (execute locally)`)
 	ctx.flags.BoolVar(&ctx.refreshState, "refresh-state", true, "Refresh terraform state for each run (turn off to save time during development)")
 	ctx.flags.MarkHidden("refresh-state")
 	return &ctx
@@ -316,22 +318,25 @@ func (c *cpContext) Init() error {
 	case "auto":
 		if hasTool("docker") == nil {
 			c.containerRuntime = runtimeDocker
+		} else if hasTool("podman") == nil {
+			c.containerRuntime = runtimePodman
 		} else if hasTool("ctr") == nil || checkPKESupported() == nil {
 			c.containerRuntime = runtimeContainerd
 		} else {
-			return errors.Errorf("neither docker, nor containerd is installed and working correctly on this machine")
+			return errors.Errorf("neither docker, podman, nor containerd is installed and working correctly on this machine")
 		}
-	case runtimeDocker:
-		if err := hasTool("docker"); err != nil {
+	case runtimeDocker, runtimePodman:
+		if err := hasTool(c.containerRuntime); err != nil {
 			return err
 		}
 	case runtimeContainerd:
 		if err := hasTool("ctr"); err != nil {
 			return err
 		}
-	case runtimeExec:
-	default:
-		return errors.Errorf("unknown container runtime: %q", c.containerRuntime)
+	}
+
+	if c.runtime, err = newContainerRuntime(c.containerRuntime); err != nil {
+		return err
 	}
 
 	err = os.MkdirAll(c.workspace, 0700)
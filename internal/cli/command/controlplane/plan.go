@@ -0,0 +1,254 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+var planFilenamePattern = regexp.MustCompile(`^[A-Za-z0-9._/-]+$`)
+
+type planOptions struct {
+	planFile      string
+	terraformInit bool
+	*cpContext
+}
+
+// planSummary is the summary of the resource changes of a terraform plan.
+type planSummary struct {
+	PlanFile string           `json:"planFile"`
+	Add      int              `json:"add"`
+	Change   int              `json:"change"`
+	Destroy  int              `json:"destroy"`
+	Changes  []resourceChange `json:"changes"`
+}
+
+type resourceChange struct {
+	Address string   `json:"address"`
+	Actions []string `json:"actions"`
+}
+
+// NewPlanCommand creates a new cobra.Command for `banzai pipeline plan`.
+func NewPlanCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := planOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the changes to deploy",
+		Long: "Create a terraform plan of deploying or upgrading Banzai Cloud Pipeline based on the values file in the workspace, " +
+			"and show a summary of the resources to add, change and destroy.\n\n" +
+			"The plan is saved in the workspace, and can be applied exactly as reviewed with `banzai pipeline up --plan-file`.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runPlan(options, banzaiCli)
+		},
+	}
+
+	options.cpContext = NewContext(cmd, banzaiCli)
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.planFile, "out", planFilename, "Name of the file in the workspace to save the plan to")
+	flags.BoolVar(&options.terraformInit, "terraform-init", true, "Run terraform init before plan")
+
+	return cmd
+}
+
+func runPlan(options planOptions, banzaiCli cli.Cli) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	if !options.valuesExists() {
+		return errors.New(fmt.Sprintf("%q is not an initialized workspace (no values file found)", options.workspace))
+	}
+
+	planFile, err := options.workspacePlanFile(options.planFile)
+	if err != nil {
+		return err
+	}
+
+	var values map[string]interface{}
+	if err := options.readValues(&values); err != nil {
+		return err
+	}
+
+	_, env, err := getImageMetadata(options.cpContext, values, true)
+	if err != nil {
+		return err
+	}
+
+	if options.terraformInit {
+		if err := initStateBackend(options.cpContext, values, env); err != nil {
+			return errors.WrapIf(err, "failed to initialize state backend")
+		}
+	}
+
+	if !options.kubeconfigExists() {
+		log.Warn("no kubeconfig found in the workspace, the plan may fail or be incomplete until `banzai pipeline up` creates the cluster")
+	}
+
+	log.Info("Planning the deployment of Banzai Cloud Pipeline...")
+
+	if err := runTerraformPlan(options.cpContext, env, planFile); err != nil {
+		return errors.WrapIf(err, "failed to plan pipeline components")
+	}
+
+	jsonFile := planFile + ".json"
+	if err := runTerraformShowPlan(options.cpContext, env, planFile, jsonFile); err != nil {
+		return errors.WrapIf(err, "failed to show plan")
+	}
+
+	raw, err := ioutil.ReadFile(filepath.Join(options.workspace, jsonFile))
+	if err != nil {
+		return errors.WrapIf(err, "failed to read plan")
+	}
+
+	summary, err := summarizePlan(raw)
+	if err != nil {
+		return err
+	}
+
+	summary.PlanFile = filepath.Join(options.workspace, planFile)
+
+	if err := writePlanSummary(banzaiCli, summary); err != nil {
+		return err
+	}
+
+	log.Infof("The plan has been saved to %q. Apply it with `banzai pipeline up --workspace %q --plan-file %q`.", summary.PlanFile, options.workspace, planFile)
+
+	return nil
+}
+
+// workspacePlanFile returns the path of a plan file relative to the workspace.
+// The plan file must be in the workspace, so it is available to the installer.
+func (c *cpContext) workspacePlanFile(name string) (string, error) {
+	path := name
+	if filepath.IsAbs(name) {
+		rel, err := filepath.Rel(c.workspace, name)
+		if err != nil {
+			return "", errors.WrapIff(err, "failed to resolve plan file %q", name)
+		}
+
+		path = rel
+	}
+
+	path = filepath.Clean(path)
+	if path == "." || path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("plan file %q must be in the workspace %q", name, c.workspace)
+	}
+
+	if !planFilenamePattern.MatchString(path) {
+		return "", errors.Errorf("invalid plan file name %q, only letters, digits, '.', '_', '-' and '/' are allowed", name)
+	}
+
+	return path, nil
+}
+
+// summarizePlan summarizes the output of `terraform show -json` of a plan the same way terraform does.
+func summarizePlan(raw []byte) (planSummary, error) {
+	var plan struct {
+		ResourceChanges []struct {
+			Address string `json:"address"`
+			Change  struct {
+				Actions []string `json:"actions"`
+			} `json:"change"`
+		} `json:"resource_changes"`
+	}
+
+	if err := json.Unmarshal(raw, &plan); err != nil {
+		return planSummary{}, errors.WrapIf(err, "failed to parse plan")
+	}
+
+	summary := planSummary{Changes: []resourceChange{}}
+	for _, rc := range plan.ResourceChanges {
+		changed := false
+		for _, action := range rc.Change.Actions {
+			switch action {
+			case "create":
+				summary.Add++
+				changed = true
+			case "update":
+				summary.Change++
+				changed = true
+			case "delete":
+				summary.Destroy++
+				changed = true
+			}
+		}
+
+		if changed {
+			summary.Changes = append(summary.Changes, resourceChange{Address: rc.Address, Actions: rc.Change.Actions})
+		}
+	}
+
+	return summary, nil
+}
+
+func writePlanSummary(banzaiCli cli.Cli, summary planSummary) error {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return output.Output(ctx, summary)
+	}
+
+	out := banzaiCli.Out()
+	for _, change := range summary.Changes {
+		fmt.Fprintf(out, "  %s %s\n", actionSymbol(change.Actions), change.Address)
+	}
+
+	if len(summary.Changes) > 0 {
+		fmt.Fprintln(out)
+	}
+
+	_, err := fmt.Fprintf(out, "Plan: %d to add, %d to change, %d to destroy.\n", summary.Add, summary.Change, summary.Destroy)
+
+	return errors.WrapIf(err, "failed to write plan summary")
+}
+
+func actionSymbol(actions []string) string {
+	switch strings.Join(actions, ",") {
+	case "create":
+		return "+"
+	case "update":
+		return "~"
+	case "delete":
+		return "-"
+	case "delete,create":
+		return "-/+"
+	case "create,delete":
+		return "+/-"
+	default:
+		return "?"
+	}
+}
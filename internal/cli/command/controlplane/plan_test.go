@@ -0,0 +1,83 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSummarizePlan(t *testing.T) {
+	raw := []byte(`{
+  "format_version": "0.1",
+  "resource_changes": [
+    {"address": "helm_release.pipeline", "change": {"actions": ["update"]}},
+    {"address": "kubernetes_secret.db", "change": {"actions": ["delete", "create"]}},
+    {"address": "kubernetes_namespace.banzaicloud", "change": {"actions": ["no-op"]}},
+    {"address": "helm_release.dex", "change": {"actions": ["create"]}},
+    {"address": "data.external.address", "change": {"actions": ["read"]}},
+    {"address": "helm_release.old", "change": {"actions": ["delete"]}}
+  ]
+}`)
+
+	summary, err := summarizePlan(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Add != 2 || summary.Change != 1 || summary.Destroy != 2 {
+		t.Errorf("summarizePlan() = %d to add, %d to change, %d to destroy, want 2, 1, 2", summary.Add, summary.Change, summary.Destroy)
+	}
+
+	var addresses []string
+	for _, change := range summary.Changes {
+		addresses = append(addresses, change.Address)
+	}
+
+	want := []string{"helm_release.pipeline", "kubernetes_secret.db", "helm_release.dex", "helm_release.old"}
+	if !reflect.DeepEqual(addresses, want) {
+		t.Errorf("summarizePlan() changes = %v, want %v", addresses, want)
+	}
+}
+
+func TestWorkspacePlanFile(t *testing.T) {
+	options := cpContext{workspace: "/home/user/.banzai/pipeline/default"}
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "pipeline.tfplan", want: "pipeline.tfplan"},
+		{name: "plans/upgrade.tfplan", want: "plans/upgrade.tfplan"},
+		{name: "/home/user/.banzai/pipeline/default/upgrade.tfplan", want: "upgrade.tfplan"},
+		{name: "/tmp/upgrade.tfplan", wantErr: true},
+		{name: "../other/upgrade.tfplan", wantErr: true},
+		{name: "my plan", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := options.workspacePlanFile(test.name)
+		if (err != nil) != test.wantErr {
+			t.Errorf("workspacePlanFile(%q) error = %v, wantErr %v", test.name, err, test.wantErr)
+			continue
+		}
+
+		if !test.wantErr && got != filepath.FromSlash(test.want) {
+			t.Errorf("workspacePlanFile(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	runtimeDocker     = "docker"
+	runtimePodman     = "podman"
+	runtimeContainerd = "containerd"
+	runtimeExec       = "exec"
+)
+
+// containerRuntime runs the commands of the installer image.
+type containerRuntime interface {
+	// name returns the name of the runtime as accepted by --container-runtime.
+	name() string
+
+	// pull pulls the image.
+	pull(image string) error
+
+	// run runs a command in a new container of the image.
+	run(image string, command []string, opts runOptions) error
+
+	// copyOut returns the contents of the regular files under source in the image, following symlinks.
+	copyOut(image string, source string) (map[string][]byte, error)
+
+	// inspect returns the metadata of a pulled image.
+	inspect(image string) (imageInfo, error)
+}
+
+// runOptions are the options of running a command with a container runtime.
+type runOptions struct {
+	mounts []mount
+	env    map[string]string
+	tty    bool
+
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// mount is a file or directory of the host bind mounted to the container.
+type mount struct {
+	source string
+	target string
+}
+
+// imageInfo is the metadata of an image.
+type imageInfo struct {
+	// RepoDigest is the reference of the image by its digest, if the runtime knows it.
+	RepoDigest string
+}
+
+// newContainerRuntime returns the container runtime with the given name.
+func newContainerRuntime(name string) (containerRuntime, error) {
+	switch name {
+	case runtimeDocker:
+		return newDockerRuntime(), nil
+	case runtimePodman:
+		return newPodmanRuntime(), nil
+	case runtimeContainerd:
+		return containerdRuntime{}, nil
+	case runtimeExec:
+		return execRuntime{}, nil
+	default:
+		return nil, errors.Errorf("unknown container runtime: %q", name)
+	}
+}
+
+// dockerRuntime runs the installer with the docker CLI, or with a CLI compatible with it.
+type dockerRuntime struct {
+	tool string
+
+	// userArgs are the arguments which make the files created in the mounts owned by the current user.
+	userArgs []string
+
+	// mountOptions are appended to the volume arguments.
+	mountOptions string
+}
+
+func newDockerRuntime() dockerRuntime {
+	return dockerRuntime{
+		tool:     "docker",
+		userArgs: []string{fmt.Sprintf("--user=%d", os.Getuid())},
+	}
+}
+
+// newPodmanRuntime returns a runtime for rootless Podman.
+// The user namespace keeps the ID of the current user, and the mounts are relabeled for SELinux.
+func newPodmanRuntime() dockerRuntime {
+	return dockerRuntime{
+		tool:         "podman",
+		userArgs:     []string{"--userns=keep-id"},
+		mountOptions: ":z",
+	}
+}
+
+func (r dockerRuntime) name() string {
+	return r.tool
+}
+
+func (r dockerRuntime) pull(image string) error {
+	return runTool(r.tool, []string{"pull", image}, runOptions{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr})
+}
+
+func (r dockerRuntime) run(image string, command []string, opts runOptions) error {
+	return runTool(r.tool, r.runArgs(image, command, opts), opts)
+}
+
+func (r dockerRuntime) runArgs(image string, command []string, opts runOptions) []string {
+	args := append([]string{"run", "--rm", "--net=host"}, r.userArgs...)
+
+	for _, m := range opts.mounts {
+		args = append(args, "-v", fmt.Sprintf("%s:%s%s", m.source, m.target, r.mountOptions))
+	}
+
+	if opts.tty {
+		args = append(args, "-ti")
+	}
+
+	// the values are passed in the environment of the CLI, so they are not logged
+	for _, key := range sortedEnvKeys(opts.env) {
+		args = append(args, "-e", key)
+	}
+
+	return append(append(args, image), command...)
+}
+
+func (r dockerRuntime) copyOut(image string, source string) (map[string][]byte, error) {
+	return copyOutWithTar(r, image, source)
+}
+
+func (r dockerRuntime) inspect(image string) (imageInfo, error) {
+	tool, err := lookupTool(r.tool)
+	if err != nil {
+		return imageInfo{}, err
+	}
+
+	ref, err := exec.Command(tool, "inspect", "-f", "{{index .RepoDigests 0}}", image).Output()
+	if err != nil {
+		return imageInfo{}, errors.WrapIf(err, "failed to inspect image")
+	}
+
+	return imageInfo{RepoDigest: strings.TrimSpace(string(ref))}, nil
+}
+
+// containerdRuntime runs the installer with the ctr CLI of containerd.
+type containerdRuntime struct{}
+
+func (containerdRuntime) name() string {
+	return runtimeContainerd
+}
+
+func (containerdRuntime) pull(image string) error {
+	return runTool("ctr", []string{"image", "pull", image}, runOptions{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr})
+}
+
+func (r containerdRuntime) run(image string, command []string, opts runOptions) error {
+	return runTool("ctr", r.runArgs(image, command, opts), opts)
+}
+
+func (containerdRuntime) runArgs(image string, command []string, opts runOptions) []string {
+	args := []string{
+		"run", "--rm", "--net-host",
+		// fmt.Sprintf("--user=%d", os.Getuid()), // TODO
+	}
+
+	for _, m := range opts.mounts {
+		args = append(args, "--mount", fmt.Sprintf("type=bind,src=%s,dst=%s,options=rbind:rw", m.source, m.target))
+	}
+
+	if opts.tty {
+		args = append(args, "-t")
+	}
+
+	for _, key := range sortedEnvKeys(opts.env) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", key, opts.env[key])) // env propagation does not work with ctr
+	}
+
+	return append(append(args, image, "banzai-cp-installer"), command...)
+}
+
+func (r containerdRuntime) copyOut(image string, source string) (map[string][]byte, error) {
+	return copyOutWithTar(r, image, source)
+}
+
+func (containerdRuntime) inspect(string) (imageInfo, error) {
+	return imageInfo{}, nil
+}
+
+// execRuntime runs the commands locally instead of the installer image (for development).
+type execRuntime struct{}
+
+func (execRuntime) name() string {
+	return runtimeExec
+}
+
+func (execRuntime) pull(string) error {
+	return nil
+}
+
+func (execRuntime) run(_ string, command []string, opts runOptions) error {
+	return runTool(command[0], command[1:], opts)
+}
+
+func (r execRuntime) copyOut(image string, source string) (map[string][]byte, error) {
+	return copyOutWithTar(r, image, source)
+}
+
+func (execRuntime) inspect(string) (imageInfo, error) {
+	return imageInfo{}, nil
+}
+
+// runTool runs a command line tool with the standard streams and the environment variables of the options.
+func runTool(tool string, args []string, opts runOptions) error {
+	path, err := lookupTool(tool)
+	if err != nil {
+		return err
+	}
+
+	log.Info(tool, " ", strings.Join(args, " "))
+
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = opts.stdin
+	cmd.Stdout = opts.stdout
+	cmd.Stderr = opts.stderr
+	cmd.Env = os.Environ()
+	for _, key := range sortedEnvKeys(opts.env) {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, opts.env[key]))
+	}
+
+	return errors.WithStack(cmd.Run())
+}
+
+// copyOutWithTar copies files from the image by streaming a tarball of them through the standard output of a container.
+func copyOutWithTar(runtime containerRuntime, image string, source string) (map[string][]byte, error) {
+	// create gzipped archive (cz) and follow symlinks (h)
+	cmd := []string{"sh", "-c", fmt.Sprintf("tar czh %s | base64", source)}
+
+	buffer := new(bytes.Buffer)
+	if err := runtime.run(image, cmd, runOptions{stdout: buffer}); err != nil {
+		return nil, errors.WrapIf(err, "failed to run container command")
+	}
+
+	contents, err := untarInMemory(base64.NewDecoder(base64.StdEncoding, buffer))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to untar source")
+	}
+
+	return contents, nil
+}
+
+func sortedEnvKeys(env map[string]string) []string {
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
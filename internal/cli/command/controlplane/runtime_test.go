@@ -0,0 +1,95 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestRuntimeRunArgs(t *testing.T) {
+	opts := runOptions{
+		mounts: []mount{{source: "/home/user/ws", target: "/workspace"}},
+		env:    map[string]string{"AWS_SECRET_ACCESS_KEY": "secret", "AWS_ACCESS_KEY_ID": "id"},
+		tty:    true,
+	}
+	command := []string{"terraform", "apply"}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: runtimeDocker,
+			args: newDockerRuntime().runArgs("installer:1.0", command, opts),
+			want: []string{
+				"run", "--rm", "--net=host", fmt.Sprintf("--user=%d", os.Getuid()),
+				"-v", "/home/user/ws:/workspace",
+				"-ti",
+				"-e", "AWS_ACCESS_KEY_ID", "-e", "AWS_SECRET_ACCESS_KEY",
+				"installer:1.0", "terraform", "apply",
+			},
+		},
+		{
+			name: runtimePodman,
+			args: newPodmanRuntime().runArgs("installer:1.0", command, opts),
+			want: []string{
+				"run", "--rm", "--net=host", "--userns=keep-id",
+				"-v", "/home/user/ws:/workspace:z",
+				"-ti",
+				"-e", "AWS_ACCESS_KEY_ID", "-e", "AWS_SECRET_ACCESS_KEY",
+				"installer:1.0", "terraform", "apply",
+			},
+		},
+		{
+			name: runtimeContainerd,
+			args: containerdRuntime{}.runArgs("installer:1.0", command, opts),
+			want: []string{
+				"run", "--rm", "--net-host",
+				"--mount", "type=bind,src=/home/user/ws,dst=/workspace,options=rbind:rw",
+				"-t",
+				"--env", "AWS_ACCESS_KEY_ID=id", "--env", "AWS_SECRET_ACCESS_KEY=secret",
+				"installer:1.0", "banzai-cp-installer", "terraform", "apply",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.args, test.want) {
+			t.Errorf("%s run arguments = %q, want %q", test.name, test.args, test.want)
+		}
+	}
+}
+
+func TestNewContainerRuntime(t *testing.T) {
+	for _, name := range []string{runtimeDocker, runtimePodman, runtimeContainerd, runtimeExec} {
+		runtime, err := newContainerRuntime(name)
+		if err != nil {
+			t.Errorf("newContainerRuntime(%q) error = %v", name, err)
+			continue
+		}
+
+		if runtime.name() != name {
+			t.Errorf("newContainerRuntime(%q).name() = %q", name, runtime.name())
+		}
+	}
+
+	if _, err := newContainerRuntime("rkt"); err == nil {
+		t.Error("newContainerRuntime() must fail for unknown runtimes")
+	}
+}
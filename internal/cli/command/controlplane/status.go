@@ -0,0 +1,309 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const pipelineNamespace = "banzaicloud"
+
+type statusOptions struct {
+	*cpContext
+}
+
+// pipelineStatus is the health of a Pipeline installation.
+type pipelineStatus struct {
+	Healthy        bool          `json:"healthy"`
+	InstallerImage string        `json:"installerImage"`
+	Endpoint       string        `json:"endpoint,omitempty"`
+	Checks         []statusCheck `json:"checks"`
+}
+
+type statusCheck struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+// NewStatusCommand creates a new cobra.Command for `banzai pipeline status`.
+func NewStatusCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := statusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Check the health of the controlplane",
+		Long: "Check the pods and Helm releases in the " + pipelineNamespace + " namespace of the cluster with the kubeconfig of the workspace, " +
+			"and whether the Pipeline API is reachable on the external address.\n\n" +
+			"The command exits with a non-zero code if the controlplane is unhealthy.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runStatus(options, banzaiCli)
+		},
+	}
+
+	options.cpContext = NewContext(cmd, banzaiCli)
+
+	return cmd
+}
+
+func runStatus(options statusOptions, banzaiCli cli.Cli) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	if !options.valuesExists() {
+		return errors.New(fmt.Sprintf("%q is not an initialized workspace (no values file found)", options.workspace))
+	}
+
+	var values map[string]interface{}
+	if err := options.readValues(&values); err != nil {
+		return err
+	}
+
+	status := pipelineStatus{
+		InstallerImage: options.installerImage(),
+		Checks:         []statusCheck{},
+	}
+
+	if !options.kubeconfigExists() {
+		status.Checks = append(status.Checks, statusCheck{
+			Kind:    "cluster",
+			Name:    "kubeconfig",
+			Status:  "missing",
+			Message: "no kubeconfig found in the workspace",
+		})
+	} else {
+		_, env, err := getImageMetadata(options.cpContext, values, false)
+		if err != nil {
+			return err
+		}
+
+		status.Checks = append(status.Checks, checkPods(options.cpContext, env)...)
+		status.Checks = append(status.Checks, checkReleases(options.cpContext, env)...)
+	}
+
+	if address, err := options.readExternalAddress(); err != nil {
+		status.Checks = append(status.Checks, statusCheck{
+			Kind:    "endpoint",
+			Name:    externalAddressFilename,
+			Status:  "missing",
+			Message: "no external address found in the workspace",
+		})
+	} else {
+		status.Endpoint = address + "pipeline"
+		status.Checks = append(status.Checks, checkEndpoint(status.Endpoint))
+	}
+
+	status.Healthy = true
+	for _, check := range status.Checks {
+		status.Healthy = status.Healthy && check.Healthy
+	}
+
+	if err := writeStatus(banzaiCli, status); err != nil {
+		return err
+	}
+
+	if !status.Healthy {
+		return errors.New("controlplane is unhealthy")
+	}
+
+	return nil
+}
+
+func checkPods(options *cpContext, env map[string]string) []statusCheck {
+	out, stderr, err := runContainerCommandOutput(options, []string{"kubectl", "get", "pods", "-ojson", "-n" + pipelineNamespace}, env)
+	if err != nil {
+		return []statusCheck{failedCheck("pods", "list", combineOutput(strings.TrimSpace(stderr), err))}
+	}
+
+	checks, err := podChecks([]byte(out))
+	if err != nil {
+		return []statusCheck{failedCheck("pods", "list", err.Error())}
+	}
+
+	if len(checks) == 0 {
+		return []statusCheck{failedCheck("pods", "list", "no pods found in the "+pipelineNamespace+" namespace")}
+	}
+
+	return checks
+}
+
+func checkReleases(options *cpContext, env map[string]string) []statusCheck {
+	out, stderr, err := runContainerCommandOutput(options, []string{"helm", "list", "--namespace", pipelineNamespace, "--all", "--output", "json"}, env)
+	if err != nil {
+		return []statusCheck{failedCheck("releases", "list", combineOutput(strings.TrimSpace(stderr), err))}
+	}
+
+	checks, err := releaseChecks([]byte(out))
+	if err != nil {
+		return []statusCheck{failedCheck("releases", "list", err.Error())}
+	}
+
+	if len(checks) == 0 {
+		return []statusCheck{failedCheck("releases", "list", "no Helm releases found in the "+pipelineNamespace+" namespace")}
+	}
+
+	return checks
+}
+
+func checkEndpoint(endpoint string) statusCheck {
+	check := statusCheck{Kind: "endpoint", Name: endpoint}
+
+	_, x509Err, err := cli.CheckPipelineEndpoint(endpoint)
+	if err != nil {
+		check.Status = "unreachable"
+		check.Message = err.Error()
+		return check
+	}
+
+	check.Status = "reachable"
+	check.Healthy = true
+	if x509Err != nil {
+		check.Message = x509Err.Error()
+	}
+
+	return check
+}
+
+func failedCheck(kind, name, message string) statusCheck {
+	return statusCheck{Kind: kind, Name: name, Status: "error", Message: strings.TrimSpace(message)}
+}
+
+// podChecks checks the pods in the output of `kubectl get pods -ojson`.
+// Running pods are healthy if all their containers are ready, and completed pods are always healthy.
+func podChecks(raw []byte) ([]statusCheck, error) {
+	var pods struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Status struct {
+				Phase             string `json:"phase"`
+				ContainerStatuses []struct {
+					Name         string `json:"name"`
+					Ready        bool   `json:"ready"`
+					RestartCount int    `json:"restartCount"`
+				} `json:"containerStatuses"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+
+	if err := json.Unmarshal(raw, &pods); err != nil {
+		return nil, errors.WrapIf(err, "failed to parse pods")
+	}
+
+	checks := make([]statusCheck, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		check := statusCheck{Kind: "pod", Name: pod.Metadata.Name, Status: pod.Status.Phase}
+
+		switch pod.Status.Phase {
+		case "Succeeded":
+			check.Healthy = true
+		case "Running":
+			ready, restarts := 0, 0
+			var notReady []string
+			for _, container := range pod.Status.ContainerStatuses {
+				restarts += container.RestartCount
+				if container.Ready {
+					ready++
+				} else {
+					notReady = append(notReady, container.Name)
+				}
+			}
+
+			check.Healthy = len(notReady) == 0
+			check.Status = fmt.Sprintf("Running %d/%d", ready, len(pod.Status.ContainerStatuses))
+			if len(notReady) > 0 {
+				check.Message = "not ready: " + strings.Join(notReady, ", ")
+			}
+			if restarts > 0 {
+				log.Debugf("pod %q has been restarted %d times", pod.Metadata.Name, restarts)
+			}
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+// releaseChecks checks the releases in the output of `helm list --output json`.
+func releaseChecks(raw []byte) ([]statusCheck, error) {
+	var releases []struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+		Chart  string `json:"chart"`
+	}
+
+	if err := json.Unmarshal(raw, &releases); err != nil {
+		return nil, errors.WrapIf(err, "failed to parse Helm releases")
+	}
+
+	checks := make([]statusCheck, 0, len(releases))
+	for _, release := range releases {
+		checks = append(checks, statusCheck{
+			Kind:    "release",
+			Name:    release.Name,
+			Status:  release.Status,
+			Healthy: release.Status == "deployed",
+			Message: release.Chart,
+		})
+	}
+
+	return checks, nil
+}
+
+func writeStatus(banzaiCli cli.Cli, status pipelineStatus) error {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return output.Output(ctx, status)
+	}
+
+	out := banzaiCli.Out()
+	fmt.Fprintf(out, "Installer image: %s\n", status.InstallerImage)
+	if status.Endpoint != "" {
+		fmt.Fprintf(out, "Endpoint: %s\n", status.Endpoint)
+	}
+	fmt.Fprintln(out)
+
+	ctx := &output.Context{
+		Out:    out,
+		Color:  banzaiCli.Color(),
+		Format: output.OutputFormatDefault,
+		Fields: []string{"Kind", "Name", "Status", "Healthy", "Message"},
+	}
+
+	return output.Output(ctx, status.Checks)
+}
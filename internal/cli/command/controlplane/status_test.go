@@ -0,0 +1,57 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"testing"
+)
+
+func TestPodChecks(t *testing.T) {
+	raw := []byte(`{"items": [
+  {"metadata": {"name": "pipeline-0"}, "status": {"phase": "Running", "containerStatuses": [{"name": "pipeline", "ready": true}, {"name": "worker", "ready": true}]}},
+  {"metadata": {"name": "dex-0"}, "status": {"phase": "Running", "containerStatuses": [{"name": "dex", "ready": false, "restartCount": 3}]}},
+  {"metadata": {"name": "migration"}, "status": {"phase": "Succeeded"}},
+  {"metadata": {"name": "vault-0"}, "status": {"phase": "Pending"}}
+]}`)
+
+	checks, err := podChecks(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]bool{"pipeline-0": true, "dex-0": false, "migration": true, "vault-0": false}
+	if len(checks) != len(want) {
+		t.Fatalf("podChecks() returned %d checks, want %d", len(checks), len(want))
+	}
+
+	for _, check := range checks {
+		if check.Healthy != want[check.Name] {
+			t.Errorf("pod %q healthy = %v, want %v", check.Name, check.Healthy, want[check.Name])
+		}
+	}
+}
+
+func TestReleaseChecks(t *testing.T) {
+	raw := []byte(`[{"name": "pipeline", "status": "deployed"}, {"name": "dex", "status": "failed"}]`)
+
+	checks, err := releaseChecks(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(checks) != 2 || !checks[0].Healthy || checks[1].Healthy {
+		t.Errorf("releaseChecks() = %+v", checks)
+	}
+}
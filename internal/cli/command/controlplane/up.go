@@ -20,6 +20,7 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
@@ -38,6 +39,7 @@ const (
 type createOptions struct {
 	init          bool
 	terraformInit bool
+	planFile      string
 	*initOptions
 }
 
@@ -64,6 +66,7 @@ func NewUpCommand(banzaiCli cli.Cli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.init, "init", "i", false, "Initialize workspace")
 	flags.BoolVar(&options.terraformInit, "terraform-init", true, "Run terraform init before apply")
+	flags.StringVar(&options.planFile, "plan-file", "", "Apply a plan file of the workspace created by banzai pipeline plan, instead of planning the changes again")
 
 	return cmd
 }
@@ -82,6 +85,22 @@ func runUp(options *createOptions, banzaiCli cli.Cli) error {
 		return err
 	}
 
+	var planFile string
+	if options.planFile != "" {
+		if !options.valuesExists() {
+			return errors.New("workspace is uninitialized, a plan file can only be applied to the workspace it was created in")
+		}
+
+		var err error
+		if planFile, err = options.workspacePlanFile(options.planFile); err != nil {
+			return err
+		}
+
+		if !fileExists(filepath.Join(options.workspace, planFile)) {
+			return errors.Errorf("plan file %q not found in the workspace", planFile)
+		}
+	}
+
 	if !options.valuesExists() {
 		if !options.init && banzaiCli.Interactive() {
 			if err := survey.AskOne(
@@ -135,6 +154,21 @@ func runUp(options *createOptions, banzaiCli cli.Cli) error {
 		}
 	}
 
+	if planFile != "" {
+		// the infrastructure is not touched, so the state matches the one the plan was created from
+		if !options.kubeconfigExists() {
+			return errors.New("could not find Kubeconfig in workspace")
+		}
+
+		log.Infof("Deploying Banzai Cloud Pipeline to Kubernetes cluster with plan %q...", planFile)
+
+		if err := runTerraformApplyPlan(options.cpContext, env, planFile); err != nil {
+			return errors.WrapIf(err, "failed to deploy pipeline components")
+		}
+
+		return postInstall(options, banzaiCli, values)
+	}
+
 	switch values["provider"] {
 	case providerPke:
 		err := ensurePKECluster(banzaiCli, options.cpContext)
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -94,11 +93,7 @@ func mergeValues(mergedValues *map[string]interface{}, defaultValues, overrideVa
 
 func imageFileExists(options *cpContext, source string) (bool, error) {
 	errorMsg := &bytes.Buffer{}
-	cmdOpt := func(cmd *exec.Cmd) error {
-		cmd.Stderr = errorMsg
-		return nil
-	}
-	if err := runContainerCommandGeneric(options, []string{"ls", source}, nil, cmdOpt); err != nil {
+	if err := runContainerCommandGeneric(options, []string{"ls", source}, runOptions{stderr: errorMsg}); err != nil {
 		if strings.Contains(errorMsg.String(), "No such file or directory") {
 			return false, nil
 		}
@@ -0,0 +1,331 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const (
+	workspaceStateDeployed    = "deployed"
+	workspaceStateNotDeployed = "not deployed"
+	workspaceStateRemote      = "remote"
+)
+
+var workspaceListFields = []string{"Name", "Provider", "Image", "UUID", "ExternalAddress", "State"}
+
+// workspaceInfo describes a workspace of the installer.
+type workspaceInfo struct {
+	Name            string `json:"name"`
+	Path            string `json:"path"`
+	Provider        string `json:"provider,omitempty"`
+	Image           string `json:"image,omitempty"`
+	UUID            string `json:"uuid,omitempty"`
+	ExternalAddress string `json:"externalAddress,omitempty"`
+	State           string `json:"state"`
+	Resources       int    `json:"resources"`
+	Kubeconfig      bool   `json:"kubeconfig"`
+	SSHKey          bool   `json:"sshKey"`
+}
+
+// NewWorkspaceCommand creates a new cobra.Command for `banzai pipeline workspace`.
+func NewWorkspaceCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "workspace",
+		Aliases: []string{"workspaces", "ws"},
+		Short:   "Manage installer workspaces",
+		Long:    "Manage the workspaces of the installer, which are stored in the pipeline directory of the banzai CLI home directory.",
+	}
+
+	cmd.AddCommand(
+		newWorkspaceListCommand(banzaiCli),
+		newWorkspaceShowCommand(banzaiCli),
+		newWorkspaceRenameCommand(banzaiCli),
+		newWorkspaceArchiveCommand(banzaiCli),
+		newWorkspaceImportCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newWorkspaceListCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List workspaces",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runWorkspaceList(banzaiCli)
+		},
+	}
+}
+
+func runWorkspaceList(banzaiCli cli.Cli) error {
+	dir := workspacesDir(banzaiCli)
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.WrapIff(err, "failed to list workspaces in %q", dir)
+	}
+
+	workspaces := make([]workspaceInfo, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		info, err := readWorkspaceInfo(entry.Name(), filepath.Join(dir, entry.Name()))
+		if err != nil {
+			log.Warnf("skipping workspace %q: %v", entry.Name(), err)
+			continue
+		}
+
+		workspaces = append(workspaces, info)
+	}
+
+	sort.Slice(workspaces, func(i, j int) bool {
+		return workspaces[i].Name < workspaces[j].Name
+	})
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: workspaceListFields,
+	}
+
+	return output.Output(ctx, workspaces)
+}
+
+func newWorkspaceShowCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "show [NAME]",
+		Aliases: []string{"get"},
+		Short:   "Show the details of a workspace",
+		Long:    "Show the details of a workspace. The default workspace is shown if no name is given.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runWorkspaceShow(banzaiCli, args)
+		},
+	}
+}
+
+func runWorkspaceShow(banzaiCli cli.Cli, args []string) error {
+	name := viper.GetString(workspaceKey)
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	if name == "" {
+		name = defaultWorkspace
+	}
+
+	path, err := workspacePath(banzaiCli, name)
+	if err != nil {
+		return err
+	}
+
+	if ok, err := dirExists(path); err != nil {
+		return err
+	} else if !ok {
+		return errors.Errorf("workspace %q not found", name)
+	}
+
+	info, err := readWorkspaceInfo(name, path)
+	if err != nil {
+		return err
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: append(append([]string{}, workspaceListFields...), "Resources", "Kubeconfig", "SSHKey", "Path"),
+	}
+
+	return output.SingleOutput(ctx, info)
+}
+
+func newWorkspaceRenameCommand(banzaiCli cli.Cli) *cobra.Command {
+	return &cobra.Command{
+		Use:     "rename NAME NEW_NAME",
+		Aliases: []string{"mv"},
+		Short:   "Rename a workspace",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runWorkspaceRename(banzaiCli, args[0], args[1])
+		},
+	}
+}
+
+func runWorkspaceRename(banzaiCli cli.Cli, name, newName string) error {
+	if err := validateWorkspaceName(name); err != nil {
+		return err
+	}
+
+	if err := validateWorkspaceName(newName); err != nil {
+		return err
+	}
+
+	dir := workspacesDir(banzaiCli)
+	path := filepath.Join(dir, name)
+	newPath := filepath.Join(dir, newName)
+
+	if ok, err := dirExists(path); err != nil {
+		return err
+	} else if !ok {
+		return errors.Errorf("workspace %q not found", name)
+	}
+
+	if _, err := os.Stat(newPath); err == nil {
+		return errors.Errorf("workspace %q already exists", newName)
+	}
+
+	if err := os.Rename(path, newPath); err != nil {
+		return errors.WrapIff(err, "failed to rename workspace %q", name)
+	}
+
+	log.Infof("workspace %q renamed to %q", name, newName)
+
+	if current := viper.GetString(workspaceKey); current == name || (current == "" && name == defaultWorkspace) {
+		log.Warnf("%q was the workspace used by default, pass --workspace=%q to use it", name, newName)
+	}
+
+	return nil
+}
+
+// workspacesDir returns the directory of the named workspaces.
+func workspacesDir(banzaiCli cli.Cli) string {
+	return filepath.Join(banzaiCli.Home(), "pipeline")
+}
+
+// workspacePath returns the path of a workspace the same way as the --workspace flag is resolved.
+func workspacePath(banzaiCli cli.Cli, name string) (string, error) {
+	path := name
+	if !strings.Contains(name, string(os.PathSeparator)) && name != "." && name != ".." {
+		path = filepath.Join(workspacesDir(banzaiCli), name)
+	}
+
+	path, err := filepath.Abs(path)
+
+	return path, errors.WrapIff(err, "failed to calculate absolute path to %q", name)
+}
+
+func validateWorkspaceName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.HasPrefix(name, ".") || strings.ContainsAny(name, `/\`) {
+		return errors.Errorf("invalid workspace name %q", name)
+	}
+
+	return nil
+}
+
+// readWorkspaceInfo collects the details of a workspace from its values file, external address and terraform state.
+func readWorkspaceInfo(name, path string) (workspaceInfo, error) {
+	options := cpContext{
+		workspace:          path,
+		installerImageRepo: defaultImage,
+		installerTag:       latestTag,
+	}
+
+	info := workspaceInfo{
+		Name:       name,
+		Path:       path,
+		State:      workspaceStateNotDeployed,
+		Kubeconfig: options.kubeconfigExists(),
+		SSHKey:     fileExists(options.sshkeyPath()),
+	}
+
+	if !options.valuesExists() {
+		return info, errors.New("no values file found")
+	}
+
+	var values map[string]interface{}
+	if err := options.readValues(&values); err != nil {
+		return info, err
+	}
+
+	info.Provider, _ = values["provider"].(string)
+	info.UUID, _ = values["uuid"].(string)
+	info.Image = options.installerImage()
+
+	if address, err := options.readExternalAddress(); err == nil {
+		info.ExternalAddress = address
+	}
+
+	if _, ok := values["state"]; ok {
+		info.State = workspaceStateRemote
+	} else if options.tfstateExists() {
+		resources, err := countStateResources(options.tfstatePath())
+		if err != nil {
+			log.Debugf("failed to read terraform state of workspace %q: %v", name, err)
+		}
+
+		info.Resources = resources
+		if resources > 0 {
+			info.State = workspaceStateDeployed
+		}
+	}
+
+	return info, nil
+}
+
+// countStateResources returns the number of managed resources in a terraform state file.
+func countStateResources(path string) (int, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, errors.WrapIf(err, "failed to read terraform state")
+	}
+
+	var state struct {
+		Resources []struct {
+			Mode string `json:"mode"`
+		} `json:"resources"`
+	}
+
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return 0, errors.WrapIf(err, "failed to parse terraform state")
+	}
+
+	count := 0
+	for _, resource := range state.Resources {
+		if resource.Mode == "managed" {
+			count++
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,564 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+const (
+	encryptedSuffix = ".enc"
+	saltSize        = 16
+)
+
+var encryptionHeader = []byte("banzai-workspace-secret-v1\n")
+
+// workspaceSecretFiles are the files of a workspace which contain secrets, relative to the workspace.
+var workspaceSecretFiles = []string{
+	sshkeyFilename,
+	"kubeconfig",
+	".kube/config",
+	tfstateFilename,
+	tfstateFilename + ".backup",
+	".terraform/" + tfstateFilename,
+	"state.tfvars",
+}
+
+type workspaceArchiveOptions struct {
+	outputFile     string
+	encrypt        bool
+	passphraseFile string
+	includeLogs    bool
+}
+
+func newWorkspaceArchiveCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := workspaceArchiveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "archive NAME",
+		Short: "Archive a workspace",
+		Long: "Create a gzipped tarball of a workspace, which can be restored with the import command on another machine.\n\n" +
+			"The SSH key, the kubeconfig, the terraform state, the state backend configuration and the saved plans contain secrets. " +
+			"With --encrypt these files are encrypted with a passphrase in the archive.",
+		Example: `  banzai pipeline workspace archive prod --encrypt -O prod.tgz
+  banzai pipeline workspace import prod.tgz`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runWorkspaceArchive(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.outputFile, "output-file", "O", "", "Path of the archive to create (default: NAME-workspace-DATE.tgz)")
+	flags.BoolVar(&options.encrypt, "encrypt", false, "Encrypt the files containing secrets with a passphrase")
+	flags.StringVar(&options.passphraseFile, "passphrase-file", "", "Read the passphrase from a file instead of asking for it")
+	flags.BoolVar(&options.includeLogs, "include-logs", false, "Include the logs of the installer runs")
+
+	return cmd
+}
+
+func runWorkspaceArchive(banzaiCli cli.Cli, options workspaceArchiveOptions, name string) error {
+	if err := validateWorkspaceName(name); err != nil {
+		return err
+	}
+
+	dir := filepath.Join(workspacesDir(banzaiCli), name)
+	if ok, err := dirExists(dir); err != nil {
+		return err
+	} else if !ok {
+		return errors.Errorf("workspace %q not found", name)
+	}
+
+	var passphrase string
+	if options.encrypt {
+		var err error
+		if passphrase, err = readPassphrase(banzaiCli, options.passphraseFile, true); err != nil {
+			return err
+		}
+	}
+
+	outputFile := options.outputFile
+	if outputFile == "" {
+		outputFile = fmt.Sprintf("%s-workspace-%s.tgz", name, time.Now().Format("20060102-1504"))
+	}
+
+	if _, err := os.Stat(outputFile); err == nil {
+		return errors.Errorf("output file named %q already exists", outputFile)
+	}
+
+	f, err := os.OpenFile(outputFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.WrapIff(err, "failed to create archive at %q", outputFile)
+	}
+
+	secrets, err := writeWorkspaceArchive(f, name, dir, passphrase, options.includeLogs)
+	if closeErr := f.Close(); err == nil {
+		err = errors.WrapIf(closeErr, "failed to close archive")
+	}
+	if err != nil {
+		_ = os.Remove(outputFile)
+		return err
+	}
+
+	log.Infof("workspace %q has been archived to %q", name, outputFile)
+	if len(secrets) > 0 && passphrase == "" {
+		log.Warnf("the archive contains secrets in plain text (%s), use --encrypt to protect them", strings.Join(secrets, ", "))
+	}
+
+	return nil
+}
+
+// writeWorkspaceArchive writes the files of a workspace to a gzipped tarball under a directory named after the workspace.
+// Secret files are encrypted if a passphrase is given. The names of the secret files found are returned.
+func writeWorkspaceArchive(w io.Writer, name, dir, passphrase string, includeLogs bool) ([]string, error) {
+	gzWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	var secrets []string
+	err := filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel == "." {
+			return nil
+		}
+
+		if info.IsDir() {
+			if rel == logsDir && !includeLogs {
+				return filepath.SkipDir
+			}
+
+			return tarWriter.WriteHeader(&tar.Header{
+				Typeflag: tar.TypeDir,
+				Name:     path.Join(name, rel) + "/",
+				Mode:     int64(info.Mode().Perm()),
+				ModTime:  info.ModTime(),
+			})
+		}
+
+		if !info.Mode().IsRegular() {
+			log.Debugf("skipping %q, it is not a regular file", rel)
+			return nil
+		}
+
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		entryName := path.Join(name, rel)
+		if isWorkspaceSecret(rel, content) {
+			secrets = append(secrets, rel)
+
+			if passphrase != "" {
+				if content, err = encryptSecret(passphrase, rel, content); err != nil {
+					return err
+				}
+
+				entryName += encryptedSuffix
+			}
+		}
+
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     entryName,
+			Mode:     int64(info.Mode().Perm()),
+			Size:     int64(len(content)),
+			ModTime:  info.ModTime(),
+		}); err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write(content)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to archive workspace")
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, errors.WrapIf(err, "failed to archive workspace")
+	}
+
+	return secrets, errors.WrapIf(gzWriter.Close(), "failed to archive workspace")
+}
+
+// isWorkspaceSecret tells whether a workspace file contains secrets.
+// Plans saved with `banzai pipeline plan --out` can have any name, so they are recognized by their content as well.
+func isWorkspaceSecret(rel string, content []byte) bool {
+	if strings.HasSuffix(rel, ".tfplan") || strings.HasSuffix(rel, ".tfplan.json") {
+		return true
+	}
+
+	for _, secret := range workspaceSecretFiles {
+		if rel == secret {
+			return true
+		}
+	}
+
+	return isTerraformPlan(content) || isTerraformPlanJSON(content)
+}
+
+// isTerraformPlan tells whether the content is a plan file saved by `terraform plan -out`, which is a zip archive with a tfplan entry.
+func isTerraformPlan(content []byte) bool {
+	if !bytes.HasPrefix(content, []byte("PK\x03\x04")) {
+		return false
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return false
+	}
+
+	for _, f := range r.File {
+		if f.Name == "tfplan" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isTerraformPlanJSON tells whether the content is the output of `terraform show -json` of a plan.
+func isTerraformPlanJSON(content []byte) bool {
+	if !bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		return false
+	}
+
+	var plan struct {
+		FormatVersion string          `json:"format_version"`
+		PlannedValues json.RawMessage `json:"planned_values"`
+	}
+	if err := json.Unmarshal(content, &plan); err != nil {
+		return false
+	}
+
+	return plan.FormatVersion != "" && plan.PlannedValues != nil
+}
+
+type workspaceImportOptions struct {
+	passphraseFile string
+}
+
+func newWorkspaceImportCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := workspaceImportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import ARCHIVE [NAME]",
+		Short: "Import an archived workspace",
+		Long: "Restore a workspace from an archive created by the archive command. " +
+			"The workspace keeps its original name unless a new one is given.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			var name string
+			if len(args) > 1 {
+				name = args[1]
+			}
+
+			return runWorkspaceImport(banzaiCli, options, args[0], name)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.passphraseFile, "passphrase-file", "", "Read the passphrase of encrypted archives from a file instead of asking for it")
+
+	return cmd
+}
+
+func runWorkspaceImport(banzaiCli cli.Cli, options workspaceImportOptions, archive, name string) error {
+	if name != "" {
+		if err := validateWorkspaceName(name); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return errors.WrapIff(err, "failed to open archive %q", archive)
+	}
+	defer f.Close()
+
+	dir := workspacesDir(banzaiCli)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.WrapIf(err, "failed to create workspaces directory")
+	}
+
+	tmpDir, err := ioutil.TempDir(dir, ".import-")
+	if err != nil {
+		return errors.WrapIf(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	passphrase := func() (string, error) {
+		return readPassphrase(banzaiCli, options.passphraseFile, false)
+	}
+
+	archivedName, err := extractWorkspaceArchive(f, tmpDir, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if name == "" {
+		name = archivedName
+	}
+
+	target := filepath.Join(dir, name)
+	if _, err := os.Stat(target); err == nil {
+		return errors.Errorf("workspace %q already exists, pass a new name to import it under", name)
+	}
+
+	if !fileExists(filepath.Join(tmpDir, archivedName, valuesFilename)) {
+		return errors.New("the archive does not contain a workspace (no values file found)")
+	}
+
+	if err := os.Rename(filepath.Join(tmpDir, archivedName), target); err != nil {
+		return errors.WrapIf(err, "failed to create workspace")
+	}
+
+	log.Infof("workspace %q has been imported, use it with --workspace=%q", name, name)
+
+	return nil
+}
+
+// extractWorkspaceArchive extracts an archive created by writeWorkspaceArchive to dir, and returns the name of the archived workspace.
+// The passphrase is only asked for if the archive contains encrypted files.
+func extractWorkspaceArchive(r io.Reader, dir string, passphrase func() (string, error)) (string, error) {
+	gzReader, err := gzip.NewReader(r)
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to uncompress archive")
+	}
+	defer gzReader.Close()
+
+	var name, pass string
+	tarReader := tar.NewReader(gzReader)
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", errors.WrapIf(err, "failed to read archive")
+		}
+
+		entryName := path.Clean(hdr.Name)
+		if path.IsAbs(entryName) || entryName == ".." || strings.HasPrefix(entryName, "../") {
+			return "", errors.Errorf("invalid path in archive: %q", hdr.Name)
+		}
+
+		parts := strings.SplitN(entryName, "/", 2)
+		if name == "" {
+			if err := validateWorkspaceName(parts[0]); err != nil {
+				return "", errors.WrapIf(err, "invalid archive")
+			}
+			name = parts[0]
+		} else if parts[0] != name {
+			return "", errors.Errorf("invalid archive, it contains more than one workspace: %q and %q", name, parts[0])
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(entryName))
+		mode := os.FileMode(hdr.Mode).Perm()
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode|0700); err != nil {
+				return "", errors.WrapIf(err, "failed to create directory")
+			}
+
+		case tar.TypeReg:
+			content, err := ioutil.ReadAll(tarReader)
+			if err != nil {
+				return "", errors.WrapIff(err, "failed to read %q from archive", hdr.Name)
+			}
+
+			if strings.HasSuffix(target, encryptedSuffix) {
+				if pass == "" {
+					if pass, err = passphrase(); err != nil {
+						return "", err
+					}
+				}
+
+				target = strings.TrimSuffix(target, encryptedSuffix)
+				rel := strings.TrimSuffix(strings.TrimPrefix(entryName, name+"/"), encryptedSuffix)
+				if content, err = decryptSecret(pass, rel, content); err != nil {
+					return "", err
+				}
+			}
+
+			if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+				return "", errors.WrapIf(err, "failed to create directory")
+			}
+
+			if err := ioutil.WriteFile(target, content, mode); err != nil {
+				return "", errors.WrapIff(err, "failed to write %q", target)
+			}
+
+		default:
+			log.Debugf("skipping %q, it is not a regular file", hdr.Name)
+		}
+	}
+
+	if name == "" {
+		return "", errors.New("the archive is empty")
+	}
+
+	return name, nil
+}
+
+// readPassphrase reads the passphrase from a file, or asks for it in interactive mode.
+func readPassphrase(banzaiCli cli.Cli, passphraseFile string, confirm bool) (string, error) {
+	if passphraseFile != "" {
+		raw, err := ioutil.ReadFile(passphraseFile)
+		if err != nil {
+			return "", errors.WrapIf(err, "failed to read passphrase file")
+		}
+
+		passphrase := strings.TrimRight(string(raw), "\r\n")
+		if passphrase == "" {
+			return "", errors.New("passphrase file is empty")
+		}
+
+		return passphrase, nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return "", errors.New("a passphrase is required, use --passphrase-file in non-interactive mode")
+	}
+
+	var passphrase string
+	if err := survey.AskOne(&survey.Password{Message: "Passphrase:"}, &passphrase, survey.WithValidator(survey.Required)); err != nil {
+		return "", errors.WrapIf(err, "failed to read passphrase")
+	}
+
+	if confirm {
+		var again string
+		if err := survey.AskOne(&survey.Password{Message: "Passphrase again:"}, &again); err != nil {
+			return "", errors.WrapIf(err, "failed to read passphrase")
+		}
+
+		if again != passphrase {
+			return "", errors.New("passphrases do not match")
+		}
+	}
+
+	return passphrase, nil
+}
+
+// encryptSecret encrypts a file with AES-GCM using a key derived from the passphrase with scrypt.
+// The name of the file is authenticated too, so encrypted files can not be swapped in the archive.
+func encryptSecret(passphrase, name string, plaintext []byte) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.WrapIf(err, "failed to generate salt")
+	}
+
+	aead, err := newSecretCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.WrapIf(err, "failed to generate nonce")
+	}
+
+	out := bytes.NewBuffer(nil)
+	out.Write(encryptionHeader)
+	out.Write(salt)
+	out.Write(nonce)
+	out.Write(aead.Seal(nil, nonce, plaintext, []byte(name)))
+
+	return out.Bytes(), nil
+}
+
+func decryptSecret(passphrase, name string, ciphertext []byte) ([]byte, error) {
+	if !bytes.HasPrefix(ciphertext, encryptionHeader) {
+		return nil, errors.Errorf("%q is not an encrypted workspace file", name)
+	}
+	ciphertext = ciphertext[len(encryptionHeader):]
+
+	if len(ciphertext) < saltSize {
+		return nil, errors.Errorf("encrypted file %q is truncated", name)
+	}
+
+	aead, err := newSecretCipher(passphrase, ciphertext[:saltSize])
+	if err != nil {
+		return nil, err
+	}
+	ciphertext = ciphertext[saltSize:]
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.Errorf("encrypted file %q is truncated", name)
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], []byte(name))
+	if err != nil {
+		return nil, errors.Errorf("failed to decrypt %q, the passphrase is wrong or the file is corrupted", name)
+	}
+
+	return plaintext, nil
+}
+
+func newSecretCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to derive key")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+
+	return aead, errors.WrapIf(err, "failed to create cipher")
+}
@@ -0,0 +1,151 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// terraformPlan returns the content of a plan file saved by `terraform plan -out`.
+func terraformPlan(t *testing.T, secret string) string {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	f, err := w.Create("tfplan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte(secret)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func TestWorkspaceArchiveRoundTrip(t *testing.T) {
+	src, err := ioutil.TempDir("", "workspace-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(src)
+
+	planJSON := `{"format_version":"0.1","planned_values":{"root_module":{}},"variables":{"password":{"value":"plan json secret"}}}`
+	files := map[string]string{
+		valuesFilename:                  "provider: kind\nuuid: 1234\n",
+		sshkeyFilename:                  "private key",
+		".kube/config":                  "kubeconfig",
+		externalAddressFilename:         "https://example.com/\n",
+		"logs/run.log":                  "log",
+		planFilename:                    terraformPlan(t, "default plan secret"),
+		planFilename + ".json":          planJSON,
+		"plans/upgrade":                 terraformPlan(t, "custom plan secret"),
+		"plans/upgrade.json":            planJSON,
+		".terraform/" + tfstateFilename: `{"backend":{"config":{"access_key":"backend state secret"}}}`,
+		"state.tfvars":                  `secret_key = "backend config secret"`,
+	}
+
+	for name, content := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive := new(bytes.Buffer)
+	secrets, err := writeWorkspaceArchive(archive, "prod", src, "secret", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		".kube/config",
+		".terraform/" + tfstateFilename,
+		planFilename,
+		planFilename + ".json",
+		"plans/upgrade",
+		"plans/upgrade.json",
+		sshkeyFilename,
+		"state.tfvars",
+	}
+	sort.Strings(secrets)
+	sort.Strings(want)
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("secrets = %v, want %v", secrets, want)
+	}
+
+	gzReader, err := gzip.NewReader(bytes.NewReader(archive.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tarball, err := ioutil.ReadAll(gzReader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"private key", "kubeconfig", "default plan secret", "custom plan secret", "plan json secret", "backend state secret", "backend config secret"} {
+		if bytes.Contains(tarball, []byte(secret)) {
+			t.Errorf("archive must not contain %q in plain text", secret)
+		}
+	}
+
+	dst, err := ioutil.TempDir("", "workspace-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dst)
+
+	if _, err := extractWorkspaceArchive(bytes.NewReader(archive.Bytes()), dst, func() (string, error) { return "wrong", nil }); err == nil {
+		t.Error("extracting with a wrong passphrase must fail")
+	}
+
+	name, err := extractWorkspaceArchive(bytes.NewReader(archive.Bytes()), dst, func() (string, error) { return "secret", nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name != "prod" {
+		t.Errorf("name = %q, want %q", name, "prod")
+	}
+
+	for file, content := range files {
+		got, err := ioutil.ReadFile(filepath.Join(dst, name, filepath.FromSlash(file)))
+		if file == "logs/run.log" {
+			if err == nil {
+				t.Error("logs must not be archived by default")
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("failed to read %q: %v", file, err)
+			continue
+		}
+		if string(got) != content {
+			t.Errorf("%q = %q, want %q", file, got, content)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type searchOptions struct {
+	repo    string
+	version string
+}
+
+func newSearchCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := searchOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "search [KEYWORD]",
+		Aliases: []string{"s", "list", "ls"},
+		Short:   "Search Helm charts in the repositories of the organization",
+		Long:    "Search Helm charts in the repositories of the organization. The keyword is matched against the chart names.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			var keyword string
+			if len(args) > 0 {
+				keyword = args[0]
+			}
+
+			return runSearch(banzaiCli, options, keyword)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.repo, "repo", "", "Search only in the given repository")
+	flags.StringVar(&options.version, "version", "", "Search for the given chart version")
+
+	return cmd
+}
+
+// chartListEntry is the structure of the items returned by the chart list API.
+type chartListEntry struct {
+	Repo   string                                     `json:"repo"`
+	Charts [][]pipeline.HelmChartDetailsResponseChart `json:"charts"`
+}
+
+type chartListItem struct {
+	Repo        string
+	Name        string
+	Version     string
+	AppVersion  string
+	Description string
+}
+
+func runSearch(banzaiCli cli.Cli, options searchOptions, keyword string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	listOpts := pipeline.HelmChartListOpts{}
+	if keyword != "" {
+		listOpts.Name = optional.NewString(keyword)
+	}
+	if options.repo != "" {
+		listOpts.Repo = optional.NewString(options.repo)
+	}
+	if options.version != "" {
+		listOpts.Version = optional.NewString(options.version)
+	}
+
+	response, _, err := banzaiCli.Client().HelmApi.HelmChartList(context.Background(), orgID, &listOpts)
+	if err != nil {
+		cli.LogAPIError("list Helm charts", err, listOpts)
+		return errors.WrapIf(err, "failed to list Helm charts")
+	}
+
+	// the API returns untyped items, convert them through JSON
+	raw, err := json.Marshal(response)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal Helm chart list")
+	}
+
+	var entries []chartListEntry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return errors.WrapIf(err, "failed to parse Helm chart list")
+	}
+
+	items := make([]chartListItem, 0)
+	for _, entry := range entries {
+		for _, versions := range entry.Charts {
+			if len(versions) == 0 {
+				continue
+			}
+
+			// the first version is the latest one matching the query
+			chart := versions[0]
+			items = append(items, chartListItem{
+				Repo:        entry.Repo,
+				Name:        chart.Name,
+				Version:     chart.Version,
+				AppVersion:  chart.AppVersion,
+				Description: chart.Description,
+			})
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Repo != items[j].Repo {
+			return items[i].Repo < items[j].Repo
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	format.HelmChartsWrite(banzaiCli, items)
+
+	return nil
+}
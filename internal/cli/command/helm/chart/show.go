@@ -0,0 +1,127 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type showOptions struct {
+	version string
+}
+
+func newShowCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := showOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "show REPO/CHART",
+		Aliases: []string{"get", "g", "inspect"},
+		Short:   "Show the details, the README and the default values of a Helm chart",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			parts := strings.SplitN(args[0], "/", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				cmd.SilenceUsage = false
+				return errors.Errorf("invalid chart reference %q, expected REPO/CHART", args[0])
+			}
+
+			return runShow(banzaiCli, options, parts[0], parts[1])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.version, "version", "", "Chart version (the latest version is shown if not specified)")
+
+	return cmd
+}
+
+func runShow(banzaiCli cli.Cli, options showOptions, repoName, chartName string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	detailsOpts := pipeline.HelmChartDetailsOpts{}
+	if options.version != "" {
+		detailsOpts.Version = optional.NewString(options.version)
+	}
+
+	details, _, err := banzaiCli.Client().HelmApi.HelmChartDetails(context.Background(), orgID, repoName, chartName, &detailsOpts)
+	if err != nil {
+		cli.LogAPIError("get Helm chart details", err, chartName)
+		return errors.WrapIfWithDetails(err, "failed to get Helm chart details", "repo", repoName, "chart", chartName)
+	}
+
+	if len(details.Versions) == 0 {
+		return errors.Errorf("could not find chart %s/%s", repoName, chartName)
+	}
+
+	for i := range details.Versions {
+		details.Versions[i].Readme = decodeChartFile(details.Versions[i].Readme)
+		details.Versions[i].Values = decodeChartFile(details.Versions[i].Values)
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return output.SingleOutput(ctx, details)
+	}
+
+	version := details.Versions[0]
+	format.HelmChartsWrite(banzaiCli, []chartListItem{{
+		Repo:        repoName,
+		Name:        version.Chart.Name,
+		Version:     version.Chart.Version,
+		AppVersion:  version.Chart.AppVersion,
+		Description: version.Chart.Description,
+	}})
+
+	if version.Readme != "" {
+		_, _ = fmt.Fprintf(banzaiCli.Out(), "\nREADME:\n%s\n", version.Readme)
+	}
+
+	if version.Values != "" {
+		_, _ = fmt.Fprintf(banzaiCli.Out(), "\nDEFAULT VALUES:\n%s\n", version.Values)
+	}
+
+	return nil
+}
+
+// decodeChartFile decodes a chart file (README or values) returned base64 encoded by Pipeline.
+// The content is returned unchanged if it is not valid base64.
+func decodeChartFile(content string) string {
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return content
+	}
+
+	return string(decoded)
+}
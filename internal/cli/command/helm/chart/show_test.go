@@ -0,0 +1,43 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestDecodeChartFile(t *testing.T) {
+	readme := "# Nginx\n\nA web server.\n"
+	values := "replicaCount: 1\nimage:\n  repository: nginx\n"
+
+	tests := map[string]struct {
+		content  string
+		expected string
+	}{
+		"encoded README": {content: base64.StdEncoding.EncodeToString([]byte(readme)), expected: readme},
+		"encoded values": {content: base64.StdEncoding.EncodeToString([]byte(values)), expected: values},
+		"plain values":   {content: values, expected: values},
+		"empty":          {content: "", expected: ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := decodeChartFile(test.content); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type addOptions struct {
+	credentialOptions
+}
+
+func newAddCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := addOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add NAME URL",
+		Aliases: []string{"a", "create"},
+		Short:   "Add a Helm repository to the organization",
+		Long:    "Add a Helm repository to the organization. Credentials of private repositories are taken from Pipeline secrets.",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runAdd(banzaiCli, options, args[0], args[1])
+		},
+	}
+
+	options.credentialOptions.addFlags(cmd)
+
+	return cmd
+}
+
+func runAdd(banzaiCli cli.Cli, options addOptions, name, url string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	passwordSecretRef, tlsSecretRef, err := options.secretRefs(banzaiCli, orgID)
+	if err != nil {
+		return errors.WrapIf(err, "failed to get repository credentials")
+	}
+
+	request := pipeline.HelmReposAddRequest{
+		Name:              name,
+		Url:               url,
+		PasswordSecretRef: passwordSecretRef,
+		TlsSecretRef:      tlsSecretRef,
+	}
+
+	log.Debugf("add repository request: %#v", request)
+
+	if _, _, err := banzaiCli.Client().HelmApi.HelmReposAdd(context.Background(), orgID, request); err != nil {
+		cli.LogAPIError("add Helm repository", err, request)
+		return errors.WrapIfWithDetails(err, "failed to add Helm repository", "name", name)
+	}
+
+	log.Infof("Helm repository %q added", name)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+const (
+	passwordSecretType = "password"
+	tlsSecretType      = "tls"
+)
+
+// NewRepoCommand returns a cobra command for `helm repo` subcommands.
+func NewRepoCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "repo",
+		Aliases: []string{"repos", "repository", "r"},
+		Short:   "Manage Helm repositories of the organization",
+	}
+
+	cmd.AddCommand(
+		newAddCommand(banzaiCli),
+		newListCommand(banzaiCli),
+		newUpdateCommand(banzaiCli),
+		newModifyCommand(banzaiCli),
+		newDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+type credentialOptions struct {
+	passwordSecret string
+	tlsSecret      string
+}
+
+func (o *credentialOptions) addFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVar(&o.passwordSecret, "password-secret", "", "Name of the password secret holding the repository credentials")
+	flags.StringVar(&o.tlsSecret, "tls-secret", "", "Name of the TLS secret holding the repository client certificate")
+}
+
+// secretRefs resolves the secrets given in the options to secret IDs, and asks the user to pick them in interactive mode.
+func (o *credentialOptions) secretRefs(banzaiCli cli.Cli, orgID int32) (passwordSecretRef string, tlsSecretRef string, err error) {
+	passwordSecretRef, err = getSecretRef(banzaiCli, orgID, passwordSecretType, o.passwordSecret)
+	if err != nil {
+		return "", "", err
+	}
+
+	tlsSecretRef, err = getSecretRef(banzaiCli, orgID, tlsSecretType, o.tlsSecret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return passwordSecretRef, tlsSecretRef, nil
+}
+
+func getSecretRef(banzaiCli cli.Cli, orgID int32, secretType string, name string) (string, error) {
+	if name == "" {
+		if !banzaiCli.Interactive() {
+			return "", nil
+		}
+
+		use := false
+		if err := survey.AskOne(&survey.Confirm{Message: "Use a " + secretType + " secret for the repository?"}, &use); err != nil {
+			return "", errors.WrapIf(err, "failed to read secret confirmation")
+		}
+		if !use {
+			return "", nil
+		}
+
+		return input.AskSecret(banzaiCli, orgID, secretType)
+	}
+
+	secrets, _, err := banzaiCli.Client().SecretsApi.GetSecrets(context.Background(), orgID, &pipeline.GetSecretsOpts{Type_: optional.NewString(secretType)})
+	if err != nil {
+		return "", errors.WrapIf(err, "could not list secrets")
+	}
+
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return secret.Id, nil
+		}
+	}
+
+	return "", errors.Errorf("can't find %s secret %q", secretType, name)
+}
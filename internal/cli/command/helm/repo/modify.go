@@ -0,0 +1,110 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+type modifyOptions struct {
+	credentialOptions
+
+	url string
+}
+
+func newModifyCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := modifyOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "modify NAME",
+		Aliases: []string{"m", "edit"},
+		Short:   "Modify the URL or the credentials of a Helm repository",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runModify(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.url, "url", "", "New URL of the repository")
+	options.credentialOptions.addFlags(cmd)
+
+	return cmd
+}
+
+func runModify(banzaiCli cli.Cli, options modifyOptions, name string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	repos, _, err := client.HelmApi.HelmListRepos(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list Helm repositories", err, orgID)
+		return errors.WrapIf(err, "failed to list Helm repositories")
+	}
+
+	var request pipeline.HelmReposModifyRequest
+	found := false
+	for _, repo := range repos {
+		if repo.Name == name {
+			request = pipeline.HelmReposModifyRequest{
+				Name:              repo.Name,
+				Url:               repo.Url,
+				PasswordSecretRef: repo.PasswordSecretRef,
+				TlsSecretRef:      repo.TlsSecretRef,
+			}
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.Errorf("could not find Helm repository named %q", name)
+	}
+
+	if options.url != "" {
+		request.Url = options.url
+	}
+
+	passwordSecretRef, tlsSecretRef, err := options.secretRefs(banzaiCli, orgID)
+	if err != nil {
+		return errors.WrapIf(err, "failed to get repository credentials")
+	}
+	if passwordSecretRef != "" {
+		request.PasswordSecretRef = passwordSecretRef
+	}
+	if tlsSecretRef != "" {
+		request.TlsSecretRef = tlsSecretRef
+	}
+
+	log.Debugf("modify repository request: %#v", request)
+
+	if _, _, err := client.HelmApi.HelmReposModify(context.Background(), orgID, name, request); err != nil {
+		cli.LogAPIError("modify Helm repository", err, request)
+		return errors.WrapIfWithDetails(err, "failed to modify Helm repository", "name", name)
+	}
+
+	log.Infof("Helm repository %q modified", name)
+
+	return nil
+}
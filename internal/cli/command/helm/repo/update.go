@@ -0,0 +1,55 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+func newUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update NAME",
+		Aliases: []string{"u", "refresh"},
+		Short:   "Update the chart index of a Helm repository",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runUpdate(banzaiCli, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, name string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if _, _, err := banzaiCli.Client().HelmApi.HelmReposUpdate(context.Background(), orgID, name); err != nil {
+		cli.LogAPIError("update Helm repository", err, name)
+		return errors.WrapIfWithDetails(err, "failed to update Helm repository", "name", name)
+	}
+
+	log.Infof("Helm repository %q updated", name)
+
+	return nil
+}
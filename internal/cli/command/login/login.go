@@ -235,8 +235,7 @@ func createPermanentToken(banzaiCli cli.Cli) error {
 }
 
 func deleteToken(banzaiCli cli.Cli, secret string) error {
-	claims := jwt.StandardClaims{}
-	_, _ = jwt.ParseWithClaims(secret, &claims, nil)
+	claims := parseTokenClaims(secret)
 
 	if err := tokenNotExpired(claims); err != nil {
 		return errors.Wrap(err, "old token is invalid")
@@ -247,8 +246,7 @@ func deleteToken(banzaiCli cli.Cli, secret string) error {
 }
 
 func isExpiringToken(secret string) (bool, error) {
-	claims := jwt.StandardClaims{}
-	_, _ = jwt.ParseWithClaims(secret, &claims, nil)
+	claims := parseTokenClaims(secret)
 
 	if err := tokenNotExpired(claims); err != nil {
 		return false, errors.Wrap(err, "old token is invalid")
@@ -257,6 +255,14 @@ func isExpiringToken(secret string) (bool, error) {
 	return claims.ExpiresAt != 0, nil
 }
 
+// parseTokenClaims returns the claims of a Pipeline token without verifying its signature.
+func parseTokenClaims(secret string) jwt.StandardClaims {
+	claims := jwt.StandardClaims{}
+	_, _ = jwt.ParseWithClaims(secret, &claims, nil)
+
+	return claims
+}
+
 func tokenNotExpired(c jwt.StandardClaims) error {
 	now := time.Now().Unix()
 
@@ -0,0 +1,105 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package login
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type whoAmI struct {
+	ID           int32  `json:"id" yaml:"id"`
+	Login        string `json:"login" yaml:"login"`
+	Name         string `json:"name" yaml:"name"`
+	Email        string `json:"email" yaml:"email"`
+	Organization string `json:"organization" yaml:"organization"`
+	TokenID      string `json:"tokenId,omitempty" yaml:"tokenId,omitempty"`
+	TokenExpiry  string `json:"tokenExpiry" yaml:"tokenExpiry"`
+	Endpoint     string `json:"endpoint" yaml:"endpoint"`
+}
+
+// NewWhoAmICommand creates a new cobra.Command for `banzai whoami`.
+func NewWhoAmICommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the current user",
+		Long:  "Show the user the CLI is logged in as, together with the selected organization, the expiry of the token and the Pipeline endpoint.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runWhoAmI(banzaiCli)
+		},
+	}
+
+	return cmd
+}
+
+func runWhoAmI(banzaiCli cli.Cli) error {
+	token := viper.GetString("pipeline.token")
+	if token == "" {
+		return errors.New("not logged in, run `banzai login` first")
+	}
+
+	user, _, err := banzaiCli.Client().UsersApi.GetCurrentUser(context.Background())
+	if err != nil {
+		cli.LogAPIError("get current user", err, nil)
+		return errors.WrapIf(err, "failed to get current user")
+	}
+
+	info := whoAmI{
+		ID:       user.Id,
+		Login:    user.Login,
+		Name:     user.Name,
+		Email:    user.Email,
+		Endpoint: viper.GetString("pipeline.basepath"),
+	}
+
+	if orgID := banzaiCli.Context().OrganizationID(); orgID != 0 {
+		org, _, err := banzaiCli.Client().OrganizationsApi.GetOrg(context.Background(), orgID)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to get organization", "orgID", orgID)
+		}
+		info.Organization = org.Name
+	}
+
+	claims := parseTokenClaims(token)
+	info.TokenID = claims.Id
+	switch {
+	case claims.ExpiresAt == 0:
+		info.TokenExpiry = "never"
+	case tokenNotExpired(claims) != nil:
+		info.TokenExpiry = "expired at " + time.Unix(claims.ExpiresAt, 0).Format(time.RFC3339)
+	default:
+		info.TokenExpiry = time.Unix(claims.ExpiresAt, 0).Format(time.RFC3339)
+	}
+
+	ctx := &output.Context{
+		Out:    banzaiCli.Out(),
+		Color:  banzaiCli.Color(),
+		Format: banzaiCli.OutputFormat(),
+		Fields: []string{"Login", "Name", "Email", "Organization", "TokenExpiry", "Endpoint"},
+	}
+
+	return output.Output(ctx, info)
+}
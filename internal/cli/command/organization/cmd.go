@@ -24,12 +24,14 @@ func NewOrganizationCommand(banzaiCli cli.Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "organization",
 		Aliases: []string{"organizations", "org", "orgs"},
-		Short:   "List and select organizations",
+		Short:   "Manage organizations",
 	}
 
 	cmd.AddCommand(
 		NewListCommand(banzaiCli),
 		NewSelectCommand(banzaiCli),
+		NewMembersCommand(banzaiCli),
+		NewSyncCommand(banzaiCli),
 	)
 
 	return cmd
@@ -0,0 +1,72 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+// NewMembersCommand creates a new cobra.Command for `banzai organization members`.
+func NewMembersCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "members [ORG NAME]",
+		Aliases: []string{"member", "users"},
+		Short:   "List the members of an organization",
+		Long:    "List the members of an organization. The selected organization is used if no organization name is given.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runMembers(banzaiCli, args)
+		},
+	}
+
+	return cmd
+}
+
+func runMembers(banzaiCli cli.Cli, args []string) error {
+	var orgID int32
+	if len(args) > 0 {
+		orgs, err := input.GetOrganizations(banzaiCli)
+		if err != nil {
+			return errors.WrapIf(err, "could not get organizations")
+		}
+
+		var found bool
+		if orgID, found = orgs[args[0]]; !found {
+			return errors.Errorf("organization %q doesn't exist", args[0])
+		}
+	} else {
+		orgID = input.GetOrganization(banzaiCli)
+	}
+
+	users, _, err := banzaiCli.Client().UsersApi.ListUsers(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list organization members", err, orgID)
+		return errors.WrapIfWithDetails(err, "failed to list organization members", "orgID", orgID)
+	}
+
+	format.UsersWrite(banzaiCli, users)
+
+	return nil
+}
@@ -30,6 +30,7 @@ func NewProcessCommand(banzaiCli cli.Cli) *cobra.Command {
 
 	cmd.AddCommand(
 		NewListCommand(banzaiCli),
+		NewGetCommand(banzaiCli),
 		NewTailCommand(banzaiCli),
 		NewCancelCommand(banzaiCli),
 	)
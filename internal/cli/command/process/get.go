@@ -0,0 +1,159 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// timelineEvent is a process event with the time it took to reach its status.
+type timelineEvent struct {
+	Id        int32                  `json:"id"`
+	ProcessId string                 `json:"processId"`
+	Type      string                 `json:"type"`
+	Status    pipeline.ProcessStatus `json:"status"`
+	Timestamp time.Time              `json:"timestamp"`
+	Duration  duration               `json:"duration,omitempty"`
+	Log       string                 `json:"log,omitempty"`
+}
+
+// duration is written the same way in every output format, for example 1m30s.
+type duration time.Duration
+
+func (d duration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d duration) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+type processDetails struct {
+	pipeline.Process
+	Duration duration        `json:"duration,omitempty"`
+	Events   []timelineEvent `json:"events"`
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai process get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get processId",
+		Aliases: []string{"show"},
+		Short:   "Get the details and the event timeline of a process",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runGet(banzaiCli, args)
+		},
+	}
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, args []string) error {
+	process, _, err := banzaiCli.Client().ProcessesApi.GetProcess(context.Background(), banzaiCli.Context().OrganizationID(), args[0])
+	if err != nil {
+		return errors.Wrap(err, "could not get process")
+	}
+
+	details := processDetails{
+		Process: process,
+		Events:  timeline(process.Events),
+	}
+
+	if process.FinishedAt != nil {
+		details.Duration = duration(process.FinishedAt.Sub(process.StartedAt))
+	}
+
+	// the events are part of the details in structured output
+	details.Process.Events = nil
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.ProcessEventsWrite(banzaiCli.Out(), banzaiCli.OutputFormat(), banzaiCli.Color(), details)
+		return nil
+	}
+
+	format.ProcessWrite(banzaiCli.Out(), banzaiCli.OutputFormat(), banzaiCli.Color(), []pipeline.Process{details.Process})
+
+	if details.Duration > 0 {
+		fmt.Fprintf(banzaiCli.Out(), "\nfinished in %s", details.Duration)
+	}
+
+	if details.Log != "" {
+		fmt.Fprintf(banzaiCli.Out(), "\n%s", details.Log)
+	}
+
+	fmt.Fprintln(banzaiCli.Out())
+	fmt.Fprintln(banzaiCli.Out())
+
+	format.ProcessEventsWrite(banzaiCli.Out(), banzaiCli.OutputFormat(), banzaiCli.Color(), details.Events)
+
+	return nil
+}
+
+// timeline returns the events in chronological order.
+// The duration of an event is the time elapsed since the previous event of the same activity.
+func timeline(events []pipeline.ProcessEvent) []timelineEvent {
+	sorted := make([]pipeline.ProcessEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	started := make(map[string]time.Time)
+	result := make([]timelineEvent, 0, len(sorted))
+	for _, e := range sorted {
+		item := timelineEvent{
+			Id:        e.Id,
+			ProcessId: e.ProcessId,
+			Type:      e.Type,
+			Status:    e.Status,
+			Timestamp: e.Timestamp,
+			Log:       e.Log,
+		}
+
+		key := e.ProcessId + "/" + e.Type
+		if start, ok := started[key]; ok {
+			item.Duration = duration(e.Timestamp.Sub(start))
+		}
+
+		if e.Status == pipeline.RUNNING {
+			started[key] = e.Timestamp
+		} else {
+			delete(started, key)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
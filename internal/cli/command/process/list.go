@@ -16,6 +16,8 @@ package process
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/antihax/optional"
@@ -25,8 +27,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const statusAll = "all"
+
+var processStatuses = []pipeline.ProcessStatus{pipeline.RUNNING, pipeline.FAILED, pipeline.FINISHED, pipeline.CANCELED}
+
 type listOptions struct {
-	format string
+	format     string
+	status     string
+	typ        string
+	resourceID string
+	since      time.Duration
 }
 
 // NewListCommand creates a new cobra.Command for `banzai process list`.
@@ -36,22 +46,50 @@ func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List processes",
-		Args:  cobra.NoArgs,
-		Run: func(cmd *cobra.Command, args []string) {
+		Long:  "List processes. By default only running processes are listed, use --status to list finished, failed or canceled ones.",
+		Example: `  banzai process list --status failed --since 24h
+  banzai process list --status all --resource-id 42`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
 
 			options.format, _ = cmd.Flags().GetString("output")
-			runList(banzaiCli, options)
+			return runList(banzaiCli, options)
 		},
 	}
 
+	flags := cmd.Flags()
+	flags.StringVar(&options.status, "status", string(pipeline.RUNNING), "List processes with the given status (running, failed, finished, canceled or all)")
+	flags.StringVar(&options.typ, "type", "", "List processes of the given type")
+	flags.StringVar(&options.resourceID, "resource-id", "", "List processes of the given resource (for example a cluster ID)")
+	flags.DurationVar(&options.since, "since", 0, "List processes started within the given duration, for example 24h")
+
 	return cmd
 }
 
 func runList(banzaiCli cli.Cli, options listOptions) error {
-	o := pipeline.ListProcessesOpts{
-		Status: optional.NewInterface(pipeline.RUNNING),
+	o := pipeline.ListProcessesOpts{}
+
+	status := strings.ToLower(options.status)
+	if status != statusAll {
+		if !isProcessStatus(status) {
+			return errors.Errorf("invalid status %q, must be one of running, failed, finished, canceled or all", options.status)
+		}
+
+		o.Status = optional.NewInterface(pipeline.ProcessStatus(status))
+	}
+
+	if options.typ != "" {
+		o.Type_ = optional.NewString(options.typ)
+	}
+
+	if options.resourceID != "" {
+		o.ResourceId = optional.NewString(options.resourceID)
+	}
+
+	if options.since < 0 {
+		return errors.New("--since must not be negative")
 	}
 
 	processes, _, err := banzaiCli.Client().ProcessesApi.ListProcesses(context.Background(), banzaiCli.Context().OrganizationID(), &o)
@@ -59,7 +97,33 @@ func runList(banzaiCli cli.Cli, options listOptions) error {
 		return errors.Wrap(err, "could not list processes")
 	}
 
+	if options.since > 0 {
+		processes = startedSince(processes, time.Now().Add(-options.since))
+	}
+
 	format.ProcessWrite(banzaiCli.Out(), options.format, banzaiCli.Color(), processes)
 
 	return nil
 }
+
+func isProcessStatus(status string) bool {
+	for _, s := range processStatuses {
+		if string(s) == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// startedSince returns the processes started after the given time.
+func startedSince(processes []pipeline.Process, since time.Time) []pipeline.Process {
+	filtered := make([]pipeline.Process, 0, len(processes))
+	for _, p := range processes {
+		if !p.StartedAt.Before(since) {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered
+}
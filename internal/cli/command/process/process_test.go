@@ -0,0 +1,89 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestTimeline(t *testing.T) {
+	start := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	events := []pipeline.ProcessEvent{
+		{Id: 3, ProcessId: "p1", Type: "drain", Status: pipeline.FINISHED, Timestamp: start.Add(90 * time.Second)},
+		{Id: 1, ProcessId: "p1", Type: "drain", Status: pipeline.RUNNING, Timestamp: start},
+		{Id: 2, ProcessId: "p1", Type: "update", Status: pipeline.RUNNING, Timestamp: start.Add(10 * time.Second)},
+		{Id: 4, ProcessId: "p1", Type: "update", Status: pipeline.FAILED, Timestamp: start.Add(2 * time.Minute)},
+	}
+
+	result := timeline(events)
+
+	expected := []struct {
+		id       int32
+		duration time.Duration
+	}{
+		{1, 0},
+		{2, 0},
+		{3, 90 * time.Second},
+		{4, 110 * time.Second},
+	}
+
+	for i, e := range expected {
+		if result[i].Id != e.id || time.Duration(result[i].Duration) != e.duration {
+			t.Errorf("expected event %d with duration %s at %d, got event %d with duration %s", e.id, e.duration, i, result[i].Id, result[i].Duration)
+		}
+	}
+
+	raw, err := json.Marshal(result[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(raw), `"duration":"1m50s"`) {
+		t.Errorf("expected the duration as a string in JSON, got %s", raw)
+	}
+
+	raw, err = yaml.Marshal(result[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(raw), "duration: 1m50s") {
+		t.Errorf("expected the duration as a string in YAML, got %s", raw)
+	}
+
+	if raw, _ := json.Marshal(result[0]); strings.Contains(string(raw), "duration") {
+		t.Errorf("expected no duration of the first event, got %s", raw)
+	}
+}
+
+func TestStartedSince(t *testing.T) {
+	now := time.Now()
+	processes := []pipeline.Process{
+		{Id: "old", StartedAt: now.Add(-48 * time.Hour)},
+		{Id: "new", StartedAt: now.Add(-time.Hour)},
+	}
+
+	filtered := startedSince(processes, now.Add(-24*time.Hour))
+	if len(filtered) != 1 || filtered[0].Id != "new" {
+		t.Errorf("expected only the new process, got %v", filtered)
+	}
+}
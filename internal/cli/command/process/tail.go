@@ -22,26 +22,31 @@ import (
 
 // NewTailCommand creates a new cobra.Command for `banzai process tail`.
 func NewTailCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := process.TailOptions{}
+
 	cmd := &cobra.Command{
 		Use:   "tail processId",
 		Short: "Tail a process",
-		Args:  cobra.ExactArgs(1),
+		Long: "Show the activities of a process until it finishes.\n\n" +
+			"With --output json every process event is written as a JSON object on its own line, " +
+			"followed by a summary of the process.\n\n" +
+			"The command exits with status 2 if the process fails.",
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 			cmd.SilenceErrors = true
 
-			return runTail(banzaiCli, args)
+			return runTail(banzaiCli, options, args)
 		},
 	}
 
+	flags := cmd.Flags()
+	flags.BoolVar(&options.FollowChildren, "follow-children", false, "Show the events of the child processes too")
+
 	return cmd
 }
 
-func runTail(banzaiCli cli.Cli, args []string) error {
-	err := process.TailProcess(banzaiCli, args[0])
-	if err != nil && !process.IsProcessFailedError(err) {
-		return err
-	}
-
-	return nil
+// runTail tails a process, a failed process is returned as an error, so the command exits with a non-zero status.
+func runTail(banzaiCli cli.Cli, options process.TailOptions, args []string) error {
+	return process.Tail(banzaiCli, args[0], options)
 }
@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewTokenCommand returns a cobra command for `token` subcommands.
+func NewTokenCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "token",
+		Aliases: []string{"tokens"},
+		Short:   "Manage API tokens",
+	}
+
+	cmd.AddCommand(
+		newCreateCommand(banzaiCli),
+		newListCommand(banzaiCli),
+		newDeleteCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+// getTokenIDs resolves token IDs or names to token IDs, or asks the user to select tokens in interactive mode.
+func getTokenIDs(banzaiCli cli.Cli, args []string) ([]string, error) {
+	if len(args) == 0 && !banzaiCli.Interactive() {
+		return nil, errors.New("at least one TOKEN argument must be specified")
+	}
+
+	tokens, _, err := banzaiCli.Client().AuthApi.ListTokens(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list tokens")
+	}
+
+	if len(args) > 0 {
+		ids := make([]string, 0, len(args))
+		for _, arg := range args {
+			id := ""
+			for _, t := range tokens {
+				if t.Id == arg || t.Name == arg {
+					if id != "" && id != t.Id {
+						return nil, errors.NewWithDetails("token name is ambiguous, use the token ID instead", "name", arg)
+					}
+					id = t.Id
+				}
+			}
+
+			if id == "" {
+				return nil, errors.NewWithDetails("token not found", "token", arg)
+			}
+
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	}
+
+	if len(tokens) == 0 {
+		return nil, errors.New("there are no tokens")
+	}
+
+	options := make([]string, len(tokens))
+	ids := make(map[string]string, len(tokens))
+	for i, t := range tokens {
+		options[i] = fmt.Sprintf("%s (%s, created at %s)", t.Name, t.Id, t.CreatedAt)
+		ids[options[i]] = t.Id
+	}
+
+	var selected []string
+	if err := survey.AskOne(&survey.MultiSelect{Message: "Tokens:", Options: options}, &selected); err != nil {
+		return nil, errors.WrapIf(err, "failed to select tokens")
+	}
+
+	result := make([]string, len(selected))
+	for i, s := range selected {
+		result[i] = ids[s]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type createOptions struct {
+	name        string
+	virtualUser string
+	ttl         time.Duration
+}
+
+func newCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"c", "new"},
+		Short:   "Create an API token",
+		Long: "Create an API token for the current user or for a virtual user (for example a CI system).\n\n" +
+			"The token is printed only once, it can not be retrieved later.",
+		Example: `  banzai token create --name ci --virtual-user ci/github --ttl 720h`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runCreate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.name, "name", "", "Name of the token")
+	flags.StringVar(&options.virtualUser, "virtual-user", "", "Create the token for a virtual user with the given name")
+	flags.DurationVar(&options.ttl, "ttl", 0, "Lifetime of the token, for example 720h (default: the token never expires)")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions) error {
+	if options.name == "" {
+		return errors.New("--name must be specified")
+	}
+
+	if options.ttl < 0 {
+		return errors.New("--ttl must not be negative")
+	}
+
+	request := pipeline.TokenCreateRequest{
+		Name:        options.name,
+		VirtualUser: options.virtualUser,
+	}
+
+	if options.ttl > 0 {
+		expiresAt := time.Now().Add(options.ttl).UTC()
+		request.ExpiresAt = &expiresAt
+	}
+
+	token, _, err := banzaiCli.Client().AuthApi.CreateToken(context.Background(), request)
+	if err != nil {
+		cli.LogAPIError("create token", err, request)
+		return errors.WrapIf(err, "failed to create token")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return output.Output(ctx, token)
+	}
+
+	log.Infof("Token %q created with ID %s. Store it safely, it will not be shown again.", token.Name, token.Id)
+	fmt.Fprintln(banzaiCli.Out(), token.Token)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+func newDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete [TOKEN...]",
+		Aliases: []string{"d", "revoke", "remove", "rm"},
+		Short:   "Revoke API tokens",
+		Long:    "Revoke API tokens by ID or by name. Clients using a revoked token can no longer access Pipeline.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runDelete(banzaiCli, args)
+		},
+	}
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, args []string) error {
+	ids, err := getTokenIDs(banzaiCli, args)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return errors.New("no tokens selected")
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		_ = survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Do you want to REVOKE %d token(s)?", len(ids))}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	for _, id := range ids {
+		if _, err := banzaiCli.Client().AuthApi.DeleteToken(context.Background(), id); err != nil {
+			cli.LogAPIError("delete token", err, id)
+			return errors.WrapIfWithDetails(err, "failed to delete token", "id", id)
+		}
+
+		log.Infof("Token %s revoked", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+func newGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get USER_ID",
+		Aliases: []string{"g", "show"},
+		Short:   "Get the details of a user of the organization",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			return runGet(banzaiCli, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, arg string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	id, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "invalid user ID", "userID", arg)
+	}
+	userID := int32(id)
+
+	user, _, err := banzaiCli.Client().UsersApi.GetUsers(context.Background(), orgID, userID)
+	if err != nil {
+		cli.LogAPIError("get user", err, userID)
+		return errors.WrapIfWithDetails(err, "failed to get user", "userID", userID)
+	}
+
+	format.UserWrite(banzaiCli, user)
+
+	return nil
+}
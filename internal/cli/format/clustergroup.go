@@ -0,0 +1,90 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClusterGroupWrite writes a cluster group to the output.
+func ClusterGroupWrite(context formatContext, data interface{}) {
+	ClusterGroupsWrite(context, []interface{}{data})
+}
+
+// ClusterGroupsWrite writes a cluster group list to the output.
+func ClusterGroupsWrite(context formatContext, data interface{}) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: []string{"Id", "Name", "Members", "EnabledFeatures"},
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ClusterGroupDeploymentWrite writes a cluster group deployment to the output.
+func ClusterGroupDeploymentWrite(context formatContext, data interface{}) {
+	ClusterGroupDeploymentsWrite(context, []interface{}{data})
+}
+
+// ClusterGroupDeploymentsWrite writes a cluster group deployment list to the output.
+func ClusterGroupDeploymentsWrite(context formatContext, data interface{}) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: []string{"ReleaseName", "Namespace", "ChartName", "ChartVersion", "Version", "UpdatedAt"},
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ClusterGroupFeaturesWrite writes a cluster group feature list to the output.
+func ClusterGroupFeaturesWrite(context formatContext, data interface{}) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: []string{"Name", "Enabled", "ReconcileState", "LastReconcileError"},
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// TargetClustersWrite writes the per-cluster status of a cluster group operation to the output.
+func TargetClustersWrite(context formatContext, data interface{}) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: []string{"ClusterId", "ClusterName", "Cloud", "Distribution", "Version", "Status", "Stale", "Error"},
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
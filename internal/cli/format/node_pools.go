@@ -33,3 +33,18 @@ func NodePoolsWrite(context formatContext, data interface{}) {
 		log.Fatal(err)
 	}
 }
+
+// NodePoolLabelsWrite writes a node pool label list to the output.
+func NodePoolLabelsWrite(context formatContext, data interface{}) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: []string{"NodePool", "Name", "Value", "Reserved"},
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -27,7 +27,22 @@ func ProcessWrite(out io.Writer, format string, color bool, data interface{}) {
 		Out:    out,
 		Color:  color,
 		Format: format,
-		Fields: []string{"Id", "Type", "ResourceId", "StartedAt"},
+		Fields: []string{"Id", "Type", "ResourceType", "ResourceId", "Status", "StartedAt"},
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ProcessEventsWrite writes a process event timeline to the output.
+func ProcessEventsWrite(out io.Writer, format string, color bool, data interface{}) {
+	ctx := &output.Context{
+		Out:    out,
+		Color:  color,
+		Format: format,
+		Fields: []string{"Timestamp", "Type", "Status", "Duration", "Log"},
 	}
 
 	err := output.Output(ctx, data)
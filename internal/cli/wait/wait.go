@@ -0,0 +1,203 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wait implements waiting for long-running Pipeline operations.
+package wait
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DefaultInterval is the initial polling interval.
+	DefaultInterval = 2 * time.Second
+
+	// MaxInterval is the maximum polling interval the backoff grows to.
+	MaxInterval = 30 * time.Second
+)
+
+// Condition checks the state of an operation.
+// It returns true when the operation has finished, and a FailedError if it has failed.
+type Condition func(ctx context.Context) (bool, error)
+
+// Options contains the flags of commands waiting for long-running operations.
+type Options struct {
+	wait     bool
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// NewOptions adds the --wait and --timeout flags to a command.
+func NewOptions(cmd *cobra.Command) *Options {
+	options := &Options{interval: DefaultInterval}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for the operation to finish")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Maximum time to wait for the operation, for example 30m (default: no timeout)")
+
+	return options
+}
+
+// Enabled returns whether the command should wait for the operation.
+func (o *Options) Enabled() bool {
+	return o.wait
+}
+
+// SetInterval sets the initial polling interval.
+func (o *Options) SetInterval(interval time.Duration) {
+	if interval > 0 {
+		o.interval = interval
+	}
+}
+
+// Until polls condition with exponential backoff until the operation finishes, fails or the timeout expires.
+//
+// Interrupting the command (Ctrl-C) only stops waiting, the operation continues in Pipeline.
+func (o *Options) Until(description string, condition Condition) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if o.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+
+	var interrupted int32
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	go func() {
+		select {
+		case <-interrupts:
+			atomic.StoreInt32(&interrupted, 1)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// stopped returns the reason of stopping before the operation finished, if any
+	stopped := func() error {
+		switch {
+		case atomic.LoadInt32(&interrupted) == 1:
+			return InterruptedError{description: description}
+		case ctx.Err() == context.DeadlineExceeded:
+			return TimeoutError{description: description, timeout: o.timeout}
+		default:
+			return nil
+		}
+	}
+
+	log.Infof("waiting for %s", description)
+
+	interval := o.interval
+	for {
+		done, err := condition(ctx)
+		if stopErr := stopped(); stopErr != nil {
+			return stopErr
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		log.Debugf("%s is in progress, checking again in %s", description, interval)
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return stopped()
+		}
+
+		interval = nextInterval(interval)
+	}
+}
+
+func nextInterval(interval time.Duration) time.Duration {
+	interval *= 2
+	if interval > MaxInterval {
+		return MaxInterval
+	}
+
+	return interval
+}
+
+// Exit codes of commands waiting for operations.
+const (
+	ExitCodeFailed      = 2
+	ExitCodeTimeout     = 3
+	ExitCodeInterrupted = 130
+)
+
+// FailedError is returned when an operation has failed in Pipeline.
+type FailedError struct {
+	msg string
+}
+
+// Failed returns a FailedError with a formatted message.
+func Failed(format string, args ...interface{}) error {
+	return FailedError{msg: fmt.Sprintf(format, args...)}
+}
+
+func (e FailedError) Error() string {
+	return e.msg
+}
+
+// ExitCode returns the exit code of the command.
+func (FailedError) ExitCode() int {
+	return ExitCodeFailed
+}
+
+// TimeoutError is returned when an operation has not finished in time.
+type TimeoutError struct {
+	description string
+	timeout     time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("%s has not finished in %s, the operation continues in Pipeline", e.description, e.timeout)
+}
+
+// ExitCode returns the exit code of the command.
+func (TimeoutError) ExitCode() int {
+	return ExitCodeTimeout
+}
+
+// InterruptedError is returned when waiting was interrupted by the user.
+type InterruptedError struct {
+	description string
+}
+
+func (e InterruptedError) Error() string {
+	return fmt.Sprintf("stopped waiting for %s, the operation continues in Pipeline", e.description)
+}
+
+// ExitCode returns the exit code of the command.
+func (InterruptedError) ExitCode() int {
+	return ExitCodeInterrupted
+}
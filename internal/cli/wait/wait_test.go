@@ -0,0 +1,75 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+func TestUntil(t *testing.T) {
+	options := &Options{interval: time.Millisecond}
+
+	calls := 0
+	err := options.Until("test", func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 checks, got %d", calls)
+	}
+}
+
+func TestUntilFailed(t *testing.T) {
+	options := &Options{interval: time.Millisecond}
+
+	err := options.Until("test", func(ctx context.Context) (bool, error) {
+		return false, Failed("cluster is in %s state", "ERROR")
+	})
+
+	var exitCoder interface{ ExitCode() int }
+	if !errors.As(errors.WrapIf(err, "failed to create cluster"), &exitCoder) || exitCoder.ExitCode() != ExitCodeFailed {
+		t.Errorf("expected a failed error, got %v", err)
+	}
+}
+
+func TestUntilTimeout(t *testing.T) {
+	options := &Options{interval: time.Millisecond, timeout: 20 * time.Millisecond}
+
+	err := options.Until("test", func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+
+	if _, ok := err.(TimeoutError); !ok {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestNextInterval(t *testing.T) {
+	if interval := nextInterval(DefaultInterval); interval != 2*DefaultInterval {
+		t.Errorf("expected %s, got %s", 2*DefaultInterval, interval)
+	}
+
+	if interval := nextInterval(MaxInterval - time.Second); interval != MaxInterval {
+		t.Errorf("expected %s, got %s", MaxInterval, interval)
+	}
+}
@@ -17,14 +17,17 @@ package process
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
 	"time"
 
 	"emperror.dev/errors"
-	"github.com/emirpasic/gods/maps/linkedhashmap"
+	"github.com/antihax/optional"
 
 	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
-	"github.com/banzaicloud/banzai-cli/pkg/spinner"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/wait"
 )
 
 const processVisibleThreshold = 3
@@ -37,89 +40,132 @@ func (e ProcessFailedError) Error() string {
 	return e.msg
 }
 
+// ExitCode returns the exit code of the command, which is the same as for other failed operations.
+func (e ProcessFailedError) ExitCode() int {
+	return wait.ExitCodeFailed
+}
+
 func IsProcessFailedError(err error) bool {
 	_, ok := err.(ProcessFailedError)
 	return ok
 }
 
+// TailOptions contains the options of tailing a process.
+type TailOptions struct {
+	// FollowChildren includes the events of the child processes.
+	FollowChildren bool
+}
+
 func TailProcess(banzaiCli cli.Cli, processId string) error {
+	return Tail(banzaiCli, processId, TailOptions{})
+}
+
+// Tail shows the events of a process until it finishes.
+//
+// With JSON output format the events are written as newline delimited JSON objects followed by a process summary,
+// otherwise the activities of the process are shown as they start and finish.
+func Tail(banzaiCli cli.Cli, processId string, options TailOptions) error {
 	client := banzaiCli.Client()
 	orgID := banzaiCli.Context().OrganizationID()
 
-	status := spinner.NewStatus()
-	status.Start(fmt.Sprintf("[%s] tailing process %s", time.Now().Local().Format(time.RFC3339), processId))
-
-	statuses := map[string]*spinner.Status{}
+	var r reporter
+	if banzaiCli.OutputFormat() == output.OutputFormatJSON {
+		r = newJSONReporter(banzaiCli.Out())
+	} else {
+		r = newTextReporter(banzaiCli.Out(), banzaiCli.Color(), processId)
+	}
+	defer r.close()
 
 	processVisibleChecks := 0
-
-	eventsToBeProcessed := linkedhashmap.New()
-	processedEvents := map[int32]pipeline.ProcessEvent{}
+	processedEvents := map[int32]bool{}
+	finishedChildren := map[string]bool{}
 
 	for {
 		process, resp, err := client.ProcessesApi.GetProcess(context.Background(), orgID, processId)
 		// we need to give some time for the process to appear
-		if resp != nil && resp.StatusCode == 404 && processVisibleChecks < processVisibleThreshold {
+		if resp != nil && resp.StatusCode == http.StatusNotFound && processVisibleChecks < processVisibleThreshold {
 			processVisibleChecks++
 			time.Sleep(2 * time.Second)
 			continue
 		}
 		if err != nil {
-			return errors.WrapIf(err, "failed to list node pool update processes")
+			return errors.WrapIf(err, "failed to get process")
 		}
-		defer resp.Body.Close()
-		status.End(true)
+		resp.Body.Close()
 
 		if resp.StatusCode < 200 || resp.StatusCode > 299 {
-			return errors.NewWithDetails("node pool update process list failed with http status code", "status_code", resp.StatusCode)
+			return errors.NewWithDetails("getting process failed with http status code", "status_code", resp.StatusCode)
 		}
 
-		for _, e := range process.Events {
-			if _, ok := processedEvents[e.Id]; !ok {
-				eventsToBeProcessed.Put(e.Id, e)
+		events := process.Events
+		if options.FollowChildren {
+			childEvents, err := listChildEvents(banzaiCli, processId, finishedChildren)
+			if err != nil {
+				return err
 			}
+
+			events = append(events, childEvents...)
 		}
 
-		for i := 0; i < len(eventsToBeProcessed.Keys()); {
-			key := eventsToBeProcessed.Keys()[i]
-			value, _ := eventsToBeProcessed.Get(key)
-			event := value.(pipeline.ProcessEvent)
+		sort.SliceStable(events, func(i, j int) bool {
+			return events[i].Timestamp.Before(events[j].Timestamp)
+		})
 
-			if s, ok := statuses[event.Type]; !ok {
-				// TODO(nandi) don't let multiple statuses run for now for different event types
-				if len(statuses) > 0 {
-					i++
-					continue
+		for _, e := range events {
+			if !processedEvents[e.Id] {
+				processedEvents[e.Id] = true
+				if err := r.event(e); err != nil {
+					return errors.WrapIf(err, "failed to write process event")
 				}
+			}
+		}
 
-				status := spinner.NewStatus()
-				status.Start(fmt.Sprintf("[%s] executing %s activity %s", event.Timestamp.Local().Format(time.RFC3339), event.Type, event.Log))
-				statuses[event.Type] = status
-
-				processedEvents[event.Id] = event
-				eventsToBeProcessed.Remove(key)
-			} else if event.Status != pipeline.RUNNING {
-				s.End(event.Status == pipeline.FINISHED)
-				delete(statuses, event.Type)
-
-				processedEvents[event.Id] = event
-				eventsToBeProcessed.Remove(key)
+		if process.Status != pipeline.RUNNING {
+			if err := r.done(process); err != nil {
+				return errors.WrapIf(err, "failed to write process summary")
+			}
 
-				// let's go back to the beginning of the stream and check for unprocessed events
-				i = 0
-			} else {
-				// TODO(nandi) don't let multiple statuses run for now for same event types
-				i++
+			if process.Status == pipeline.FINISHED {
+				return nil
 			}
-		}
 
-		if process.Status == pipeline.FINISHED {
-			_, _ = fmt.Fprintf(banzaiCli.Out(), "%s process finished", process.Type)
-			return nil
-		} else if process.Status != pipeline.RUNNING {
 			return ProcessFailedError{fmt.Sprintf("%s process %s: %s", process.Type, process.Status, process.Log)}
 		}
 
 		time.Sleep(2 * time.Second)
 	}
 }
+
+// listChildEvents returns the events of the child processes of a process.
+// Child processes in finished are not queried again, and the ones which have finished since are added to it.
+func listChildEvents(banzaiCli cli.Cli, processId string, finished map[string]bool) ([]pipeline.ProcessEvent, error) {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	children, _, err := client.ProcessesApi.ListProcesses(context.Background(), orgID, &pipeline.ListProcessesOpts{
+		ParentId: optional.NewString(processId),
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list child processes")
+	}
+
+	var events []pipeline.ProcessEvent
+	for _, child := range children {
+		if finished[child.Id] {
+			continue
+		}
+
+		process, _, err := client.ProcessesApi.GetProcess(context.Background(), orgID, child.Id)
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to get child process")
+		}
+
+		events = append(events, process.Events...)
+
+		if process.Status != pipeline.RUNNING {
+			finished[child.Id] = true
+		}
+	}
+
+	return events, nil
+}
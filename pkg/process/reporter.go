@@ -0,0 +1,250 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-isatty"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/pkg/spinner"
+)
+
+// reporter shows the progress of a process.
+type reporter interface {
+	// event is called for every event of the process in chronological order
+	event(e pipeline.ProcessEvent) error
+
+	// done is called when the process is not running anymore
+	done(p pipeline.Process) error
+
+	close()
+}
+
+// textReporter shows the activities of a process for humans.
+// Activities may run in parallel, a spinner shows the running ones on terminals,
+// and a line is written when an activity starts (without a terminal) or ends.
+type textReporter struct {
+	out       io.Writer
+	color     bool
+	processId string
+	spinner   *spinner.Spinner
+
+	// running activities by process ID and event type, in the order they started
+	running map[string]string
+	order   []string
+}
+
+func newTextReporter(out io.Writer, color bool, processId string) *textReporter {
+	r := &textReporter{
+		out:       out,
+		color:     color,
+		processId: processId,
+		running:   make(map[string]string),
+	}
+
+	if isTerminal(out) {
+		r.spinner = spinner.NewSpinner(out)
+		r.updateSpinner()
+	} else {
+		_, _ = fmt.Fprintf(out, " • [%s] tailing process %s ...\n", time.Now().Local().Format(time.RFC3339), processId)
+	}
+
+	return r
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && isatty.IsTerminal(f.Fd())
+}
+
+func (r *textReporter) event(e pipeline.ProcessEvent) error {
+	key := e.ProcessId + "/" + e.Type
+	message := fmt.Sprintf("[%s] %s activity %s", e.Timestamp.Local().Format(time.RFC3339), e.Type, e.Log)
+	if e.ProcessId != r.processId {
+		message += fmt.Sprintf(" (child process %s)", e.ProcessId)
+	}
+
+	if e.Status == pipeline.RUNNING {
+		if _, ok := r.running[key]; !ok {
+			r.order = append(r.order, key)
+		}
+		r.running[key] = message
+
+		if r.spinner == nil {
+			return r.writeLine("•", message+" ...")
+		}
+
+		r.updateSpinner()
+
+		return nil
+	}
+
+	if started, ok := r.running[key]; ok {
+		message = started
+		delete(r.running, key)
+		for i, k := range r.order {
+			if k == key {
+				r.order = append(r.order[:i], r.order[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if e.Status != pipeline.FINISHED {
+		message += fmt.Sprintf(" %s", e.Status)
+	}
+
+	err := r.writeResult(e.Status == pipeline.FINISHED, message)
+	r.updateSpinner()
+
+	return err
+}
+
+func (r *textReporter) done(p pipeline.Process) error {
+	r.close()
+
+	message := fmt.Sprintf("%s process %s", p.Type, p.Status)
+	if p.Status != pipeline.FINISHED && p.Log != "" {
+		message += ": " + p.Log
+	}
+
+	return r.writeResult(p.Status == pipeline.FINISHED, message)
+}
+
+func (r *textReporter) close() {
+	if r.spinner != nil {
+		r.spinner.Stop()
+		r.spinner = nil
+		_, _ = fmt.Fprint(r.out, "\r\x1b[2K")
+	}
+}
+
+func (r *textReporter) updateSpinner() {
+	if r.spinner == nil {
+		return
+	}
+
+	var suffix string
+	switch len(r.order) {
+	case 0:
+		suffix = fmt.Sprintf("tailing process %s", r.processId)
+	case 1:
+		suffix = "executing " + r.running[r.order[0]]
+	default:
+		types := make([]string, 0, len(r.order))
+		for _, key := range r.order {
+			types = append(types, key[strings.LastIndex(key, "/")+1:])
+		}
+		suffix = fmt.Sprintf("executing %d activities: %s", len(r.order), strings.Join(types, ", "))
+	}
+
+	r.spinner.SetSuffix(" " + suffix + " ")
+	r.spinner.Start()
+}
+
+func (r *textReporter) writeResult(success bool, message string) error {
+	mark, color := "✓", "\x1b[32m"
+	if !success {
+		mark, color = "✗", "\x1b[31m"
+	}
+
+	if r.color {
+		mark = color + mark + "\x1b[0m"
+	}
+
+	return r.writeLine(mark, message)
+}
+
+func (r *textReporter) writeLine(mark string, message string) error {
+	if r.spinner != nil {
+		r.spinner.Stop()
+		_, _ = fmt.Fprint(r.out, "\r\x1b[2K")
+	}
+
+	_, err := fmt.Fprintf(r.out, " %s %s\n", mark, message)
+
+	return err
+}
+
+// jsonReporter writes the events and the summary of a process as newline delimited JSON.
+type jsonReporter struct {
+	encoder *json.Encoder
+	events  int
+}
+
+type jsonEvent struct {
+	Kind      string                 `json:"kind"`
+	Id        int32                  `json:"id"`
+	ProcessId string                 `json:"processId"`
+	Type      string                 `json:"type"`
+	Status    pipeline.ProcessStatus `json:"status"`
+	Timestamp time.Time              `json:"timestamp"`
+	Log       string                 `json:"log,omitempty"`
+}
+
+type jsonSummary struct {
+	Kind         string                 `json:"kind"`
+	Id           string                 `json:"id"`
+	Type         string                 `json:"type"`
+	ResourceType string                 `json:"resourceType,omitempty"`
+	ResourceId   string                 `json:"resourceId,omitempty"`
+	Status       pipeline.ProcessStatus `json:"status"`
+	StartedAt    time.Time              `json:"startedAt"`
+	FinishedAt   *time.Time             `json:"finishedAt,omitempty"`
+	Log          string                 `json:"log,omitempty"`
+	Events       int                    `json:"events"`
+}
+
+func newJSONReporter(out io.Writer) *jsonReporter {
+	return &jsonReporter{encoder: json.NewEncoder(out)}
+}
+
+func (r *jsonReporter) event(e pipeline.ProcessEvent) error {
+	r.events++
+
+	return r.encoder.Encode(jsonEvent{
+		Kind:      "event",
+		Id:        e.Id,
+		ProcessId: e.ProcessId,
+		Type:      e.Type,
+		Status:    e.Status,
+		Timestamp: e.Timestamp,
+		Log:       e.Log,
+	})
+}
+
+func (r *jsonReporter) done(p pipeline.Process) error {
+	return r.encoder.Encode(jsonSummary{
+		Kind:         "process",
+		Id:           p.Id,
+		Type:         p.Type,
+		ResourceType: p.ResourceType,
+		ResourceId:   p.ResourceId,
+		Status:       p.Status,
+		StartedAt:    p.StartedAt,
+		FinishedAt:   p.FinishedAt,
+		Log:          p.Log,
+		Events:       r.events,
+	})
+}
+
+func (r *jsonReporter) close() {}
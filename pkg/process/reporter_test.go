@@ -0,0 +1,105 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func testEvents() []pipeline.ProcessEvent {
+	start := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	return []pipeline.ProcessEvent{
+		{Id: 1, ProcessId: "p1", Type: "drain", Status: pipeline.RUNNING, Timestamp: start},
+		{Id: 2, ProcessId: "p1", Type: "update", Status: pipeline.RUNNING, Timestamp: start.Add(time.Second)},
+		{Id: 3, ProcessId: "p1", Type: "update", Status: pipeline.FAILED, Timestamp: start.Add(2 * time.Second)},
+		{Id: 4, ProcessId: "p1", Type: "drain", Status: pipeline.FINISHED, Timestamp: start.Add(3 * time.Second)},
+	}
+}
+
+func TestTextReporterParallelActivities(t *testing.T) {
+	var out bytes.Buffer
+	r := newTextReporter(&out, false, "p1")
+
+	for _, e := range testEvents() {
+		if err := r.event(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := r.done(pipeline.Process{Id: "p1", Type: "update-node-pool", Status: pipeline.FAILED, Log: "update failed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("expected 6 lines, got %d:\n%s", len(lines), out.String())
+	}
+
+	expected := []string{" •", " •", " •", " ✗", " ✓", " ✗"}
+	for i, prefix := range expected {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Errorf("expected line %d to start with %q, got %q", i, prefix, lines[i])
+		}
+	}
+
+	if !strings.Contains(lines[3], "update activity") || !strings.Contains(lines[4], "drain activity") {
+		t.Errorf("expected both parallel activities to be reported, got:\n%s", out.String())
+	}
+}
+
+func TestJSONReporter(t *testing.T) {
+	var out bytes.Buffer
+	r := newJSONReporter(&out)
+
+	for _, e := range testEvents() {
+		if err := r.event(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := r.done(pipeline.Process{Id: "p1", Type: "update-node-pool", Status: pipeline.FINISHED}); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 lines, got %d", len(lines))
+	}
+
+	var event jsonEvent
+	if err := json.Unmarshal([]byte(lines[2]), &event); err != nil {
+		t.Fatal(err)
+	}
+
+	if event.Kind != "event" || event.Id != 3 || event.Status != pipeline.FAILED {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	var summary jsonSummary
+	if err := json.Unmarshal([]byte(lines[4]), &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Kind != "process" || summary.Events != 4 || summary.Status != pipeline.FINISHED {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+}